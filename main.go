@@ -1,9 +1,15 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"github.com/mikey-wotton/go-mars-rover/parser"
+	"github.com/mikey-wotton/go-mars-rover/rover"
 	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 )
 
 const input = `5 5
@@ -13,19 +19,98 @@ LMLMLMLMM
 MMRMMRMRRM`
 
 func main() {
-	rovers, err := parser.ParseInstructions(input)
+	lockstep := flag.Bool("lockstep", false, "run every rover at once, one instruction per tick")
+	heightmap := flag.String("heightmap", "", "load the plateau's elevation from a CSV, PGM or PNG file")
+	heightScale := flag.Float64("heightscale", 10, "elevation of white in a PGM or PNG heightmap")
+	render := flag.Bool("render", false, "draw the plateau before and after the mission")
+	flag.Parse()
+
+	mission, err := parser.ParseMission(input)
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	for _, r := range rovers {
-		fmt.Println(fmt.Sprintf("Starting Position (%d, %d) Facing %s", r.Position.X, r.Position.Y, r.Position.Direction.String()))
-		fmt.Println(fmt.Sprintf("Instructions: %s", r.Commands))
-		err := r.Explore()
-		if err != nil {
+	if *heightmap != "" {
+		if err := loadHeightmap(mission, *heightmap, *heightScale); err != nil {
 			log.Fatal(err)
 		}
+	}
+
+	if *lockstep {
+		mission.Mode = rover.Lockstep
+	}
+
+	starts := make([]rover.Position, len(mission.Rovers))
+	for i, r := range mission.Rovers {
+		starts[i] = *r.Position
+	}
+
+	if *render {
+		fmt.Println(mission.Rovers.Render())
+	}
+
+	_, err = mission.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *render {
+		fmt.Println(mission.Rovers.Render())
+	}
+
+	for i, r := range mission.Rovers {
+		name := r.Name
+		if name == "" {
+			name = strconv.Itoa(i)
+		}
+		fmt.Println(fmt.Sprintf("Rover %s", name))
+		fmt.Println(fmt.Sprintf("Starting Position (%d, %d) Facing %s", starts[i].X, starts[i].Y, starts[i].Direction.String()))
+		fmt.Println(fmt.Sprintf("Instructions: %s", r.Commands))
 		fmt.Println(fmt.Sprintf("Finishing Position (%d, %d) Facing %s", r.Position.X, r.Position.Y, r.Position.Direction.String()))
+		if r.Battery != nil {
+			fmt.Println(fmt.Sprintf("Remaining Charge %g of %g", r.Battery.Charge, r.Battery.Capacity))
+		}
 		fmt.Println()
 	}
+
+	//only missions doing science have anything to report
+	for _, r := range mission.Rovers {
+		if r.Payload != nil {
+			fmt.Print(mission.Report())
+			break
+		}
+	}
+}
+
+//loadHeightmap loads the elevation in the file onto the plateau shared by every rover of the mission, with the bottom
+//left of the file at the plateau's origin.
+func loadHeightmap(mission *rover.Mission, path string, scale float64) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	plateau, origin := &rover.Plateau{}, rover.Coordinate{}
+	if len(mission.Rovers) > 0 {
+		origin = mission.Rovers[0].Origin
+		if mission.Rovers[0].Plateau != nil {
+			plateau = mission.Rovers[0].Plateau
+		}
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		err = plateau.LoadElevationCSV(file, origin)
+	} else {
+		err = plateau.LoadElevationImage(file, origin, scale)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, r := range mission.Rovers {
+		r.Plateau = plateau
+	}
+
+	return mission.Valid()
 }
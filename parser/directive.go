@@ -0,0 +1,537 @@
+package parser
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/mikey-wotton/go-mars-rover/rover"
+	"strconv"
+	"strings"
+)
+
+//directiveValues is the number of values each mission directive expects after its name.
+var directiveValues = map[string]int{
+	"mode":       1,
+	"grid":       1,
+	"topology":   1,
+	"rightofway": 1,
+	"obstacle":   2, //X, Y
+	"cost":       2, //Instruction, energy
+	"clock":      3, //Sol length, charge rate, night drain
+	"elevation":  3, //X, Y, height
+	"climb":      1, //Energy per unit of elevation climbed
+	"terrain":    1, //Number of rows in the terrain map which follows
+	"seed":       1,
+	"fault":      3,              //Instruction or terrain, fault, probability
+	"landmark":   3,              //X, Y, accuracy
+	"site":       3,              //X, Y, name
+	"model":      variadicValues, //Name, options
+	"polygon":    variadicValues, //X, Y of each vertex
+	"mask":       1,              //Number of rows in the mask which follows
+	"fence":      variadicValues, //Name, X, Y of each corner or vertex, optional ticks
+	"hazard":     variadicValues, //Name, X, Y on each tick, optional repeat
+}
+
+//variadicValues marks a directive which expects one or more values.
+const variadicValues = -1
+
+const (
+	//rectangleValues is the number of values describing a rectangle, the X and Y of its lower-left and upper-right.
+	rectangleValues = 4
+	//ticksOption prefixes the ticks a geofence is active for.
+	ticksOption = "ticks="
+	//repeatOption ends the path of a hazard which starts its path again once it reaches the end.
+	repeatOption = "repeat"
+)
+
+//obstacleLegend marks an obstacle in a terrain map, alongside the rover.TerrainLegend.
+const obstacleLegend = '#'
+
+//missionState holds what has been parsed of a mission so far. The plateau is only created once a directive describes
+//it, so that rovers on a flat and clear plateau are left without one.
+type missionState struct {
+	mission  *rover.Mission
+	grid     rover.Grid
+	topology rover.Topology
+	origin   rover.Coordinate
+	plateau  *rover.Plateau
+	costs    map[rover.Instruction]float64
+	climb    float64
+	models   map[string]*rover.Model
+}
+
+func (s *missionState) faults() *rover.Faults {
+	if s.mission.Faults == nil {
+		s.mission.Faults = &rover.Faults{}
+	}
+
+	return s.mission.Faults
+}
+
+func (s *missionState) surface() *rover.Plateau {
+	if s.plateau == nil {
+		s.plateau = &rover.Plateau{}
+	}
+
+	return s.plateau
+}
+
+//share gives every rover what the directives describe for the whole mission, returning true if anything was shared.
+func (s *missionState) share() bool {
+	for _, r := range s.mission.Rovers {
+		if s.plateau != nil {
+			r.Plateau = s.plateau
+		}
+
+		if s.costs != nil && r.Battery != nil && r.Battery.Costs == nil {
+			r.Battery.Costs = s.costs
+		}
+
+		if s.climb != 0 && r.Battery != nil && r.Battery.ClimbCost == 0 {
+			r.Battery.ClimbCost = s.climb
+		}
+	}
+
+	return s.plateau != nil || s.costs != nil || s.climb != 0
+}
+
+func parseDirective(scanner *bufio.Scanner, state *missionState) error {
+	line := scanner.Text()
+
+	strs := strings.Split(strings.TrimPrefix(line, directivePrefix), " ")
+	name, values := strs[0], strs[1:]
+
+	expected, known := directiveValues[name]
+	if !known {
+		return fmt.Errorf("%w %s", ErrUnknownDirective, name)
+	}
+	if len(values) != expected && (expected != variadicValues || len(values) == 0) {
+		return fmt.Errorf("%w %s", ErrInvalidDirective, line)
+	}
+
+	var err error
+	switch name {
+	case "mode":
+		state.mission.Mode, err = stringToMode(values[0])
+	case "rightofway":
+		state.mission.RightOfWay, err = stringToRightOfWay(values[0])
+	case "grid":
+		//rovers are checked against the grid as they are parsed, so it cannot change once one has been
+		if len(state.mission.Rovers) > 0 {
+			return fmt.Errorf("%w %s : grid must be set before any rover", ErrInvalidDirective, line)
+		}
+		state.grid, err = stringToGrid(values[0])
+	case "topology":
+		//as with the grid, rovers are checked against the topology as they are parsed
+		if len(state.mission.Rovers) > 0 {
+			return fmt.Errorf("%w %s : topology must be set before any rover", ErrInvalidDirective, line)
+		}
+		state.topology, err = stringToTopology(values[0])
+	case "obstacle":
+		var obstacle rover.Coordinate
+		obstacle, err = parseCoordinate(values)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		state.surface().AddObstacle(obstacle)
+	case "cost":
+		instruction, cost, err := parseCost(values)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		if state.costs == nil {
+			state.costs = make(map[rover.Instruction]float64)
+		}
+		state.costs[instruction] = cost
+	case "clock":
+		state.mission.Clock, err = parseClock(values)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		err = state.mission.Clock.Valid()
+	case "elevation":
+		coordinate, height, err := parseCoordinateValue(values)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		state.surface().SetElevation(coordinate, height)
+	case "terrain":
+		if err := parseTerrainMap(scanner, values[0], state); err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+	case "landmark":
+		coordinate, accuracy, err := parseCoordinateValue(values)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		state.surface().AddLandmark(coordinate, accuracy)
+	case "site":
+		var site rover.Coordinate
+		site, err = parseCoordinate(values)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		state.surface().AddSampleSite(site, values[2])
+	case "polygon":
+		polygon, err := parsePolygon(values)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		state.surface().Shape = polygon
+	case "mask":
+		mask, err := parseMask(scanner, values[0], state.origin)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		state.surface().Shape = mask
+	case "fence":
+		fence, err := parseGeofence(values)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		state.surface().AddGeofence(fence)
+	case "hazard":
+		hazard, err := parseHazard(values)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		state.surface().AddHazard(hazard)
+	case "model":
+		model, err := parseModel(values)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		if state.models == nil {
+			state.models = make(map[string]*rover.Model)
+		}
+		state.models[model.Name] = model
+	case "seed":
+		state.faults().Seed, err = strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+	case "fault":
+		if err := parseFault(values, state.faults()); err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+		err = state.mission.Faults.Valid()
+	case "climb":
+		state.climb, err = strconv.ParseFloat(values[0], 64)
+		if err != nil {
+			return fmt.Errorf("%w %s : %v", ErrInvalidDirective, line, err)
+		}
+	}
+
+	return err
+}
+
+//parseCost parses an instruction and the energy it costs, e.g. "M 1.5".
+func parseCost(strs []string) (rover.Instruction, float64, error) {
+	runes := []rune(strs[0])
+	if len(runes) != 1 {
+		return 0, 0, fmt.Errorf("one instruction expected but got %s", strs[0])
+	}
+
+	instruction := rover.Instruction(runes[0])
+	if err := instruction.Valid(); err != nil {
+		return 0, 0, err
+	}
+
+	cost, err := strconv.ParseFloat(strs[1], 64)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return instruction, cost, nil
+}
+
+//parsePolygon parses the X and Y of each vertex of a polygon in turn, e.g. "0 0 4 0 0 4".
+func parsePolygon(strs []string) (rover.Polygon, error) {
+	if len(strs)%2 != 0 {
+		return nil, fmt.Errorf("polygon vertices need an x and y but got %d values", len(strs))
+	}
+
+	polygon := make(rover.Polygon, 0, len(strs)/2)
+	for i := 0; i < len(strs); i += 2 {
+		vertex, err := parseCoordinate(strs[i:])
+		if err != nil {
+			return nil, err
+		}
+		polygon = append(polygon, vertex)
+	}
+
+	return polygon, polygon.Valid()
+}
+
+//parseGeofence parses a named geofence, either a rectangle from its lower-left to its upper-right corner or a polygon
+//of three or more vertices, optionally followed by the ticks it is active for, e.g. "blast 2 2 4 4 ticks=3-10". Either
+//tick may be left out, "ticks=3-" is active from tick 3 onwards and "ticks=-10" until tick 10.
+func parseGeofence(strs []string) (rover.Geofence, error) {
+	fence := rover.Geofence{Name: strs[0]}
+
+	coordinates := strs[1:]
+	if last := len(coordinates) - 1; last >= 0 && strings.HasPrefix(coordinates[last], ticksOption) {
+		ticks := strings.SplitN(strings.TrimPrefix(coordinates[last], ticksOption), "-", 2)
+		if len(ticks) != 2 {
+			return fence, fmt.Errorf("geofence ticks must be start-end but got %s", coordinates[last])
+		}
+
+		for i, tick := range []*int{&fence.Start, &fence.End} {
+			if ticks[i] == "" {
+				continue
+			}
+
+			var err error
+			if *tick, err = strconv.Atoi(ticks[i]); err != nil {
+				return fence, err
+			}
+		}
+		coordinates = coordinates[:last]
+	}
+
+	if len(coordinates) == rectangleValues {
+		lower, err := parseCoordinate(coordinates)
+		if err != nil {
+			return fence, err
+		}
+		upper, err := parseCoordinate(coordinates[2:])
+		if err != nil {
+			return fence, err
+		}
+		fence.Shape = rover.Rectangle{Min: lower, Max: upper}
+	} else {
+		polygon, err := parsePolygon(coordinates)
+		if err != nil {
+			return fence, err
+		}
+		fence.Shape = polygon
+	}
+
+	return fence, fence.Valid()
+}
+
+//parseHazard parses a named hazard followed by the X and Y of its path on each tick, optionally ending with repeat,
+//e.g. "dust 0 0 1 0 2 0 repeat".
+func parseHazard(strs []string) (rover.Hazard, error) {
+	hazard := rover.Hazard{Name: strs[0]}
+
+	values := strs[1:]
+	if last := len(values) - 1; last >= 0 && values[last] == repeatOption {
+		hazard.Repeat = true
+		values = values[:last]
+	}
+
+	if len(values)%2 != 0 {
+		return hazard, fmt.Errorf("hazard path needs an x and y on each tick but got %d values", len(values))
+	}
+
+	for i := 0; i < len(values); i += 2 {
+		c, err := parseCoordinate(values[i:])
+		if err != nil {
+			return hazard, err
+		}
+		hazard.Path = append(hazard.Path, c)
+	}
+
+	return hazard, hazard.Valid()
+}
+
+//parseMask parses the given number of rows following a mask directive into a rover.Mask anchored at the plateau's
+//origin.
+func parseMask(scanner *bufio.Scanner, count string, origin rover.Coordinate) (rover.Mask, error) {
+	rows, err := strconv.Atoi(count)
+	if err != nil {
+		return nil, err
+	}
+	if rows < 1 {
+		return nil, fmt.Errorf("mask needs at least one row but got %d", rows)
+	}
+
+	lines := make([]string, rows)
+	for row := range lines {
+		if !scanner.Scan() {
+			return nil, fmt.Errorf("mask ended %d rows early", rows-row)
+		}
+		lines[row] = scanner.Text()
+	}
+
+	return rover.LoadMask(strings.NewReader(strings.Join(lines, "\n")), origin)
+}
+
+//parseTerrainMap parses the given number of rows following a terrain directive, each a line of characters from the
+//rover.TerrainLegend or '#' for an obstacle. The first row is the northern most and the last row starts at the
+//plateau's origin.
+func parseTerrainMap(scanner *bufio.Scanner, count string, state *missionState) error {
+	rows, err := strconv.Atoi(count)
+	if err != nil {
+		return err
+	}
+	if rows < 1 {
+		return fmt.Errorf("terrain map needs at least one row but got %d", rows)
+	}
+
+	for row := rows - 1; row >= 0; row-- {
+		if !scanner.Scan() {
+			return fmt.Errorf("terrain map ended %d rows early", row+1)
+		}
+
+		for column, legend := range []rune(scanner.Text()) {
+			x, y := state.origin.X+column, state.origin.Y+row
+			c := rover.Coordinate{X: x, Y: y}
+			if legend == obstacleLegend {
+				state.surface().AddObstacle(c)
+				continue
+			}
+
+			terrain, ok := rover.TerrainLegend[legend]
+			if !ok {
+				return fmt.Errorf("unknown terrain %q at (%d, %d)", legend, x, y)
+			}
+			if terrain != rover.Ground {
+				state.surface().SetTerrain(c, terrain)
+			}
+		}
+	}
+
+	return nil
+}
+
+//parseFault parses the probability of a fault for an instruction or a terrain, e.g. "M skip 0.1" or "sand stall 0.2".
+func parseFault(strs []string, faults *rover.Faults) error {
+	fault, err := stringToFault(strs[1])
+	if err != nil {
+		return err
+	}
+
+	rate, err := strconv.ParseFloat(strs[2], 64)
+	if err != nil {
+		return err
+	}
+
+	if terrain, err := stringToTerrain(strs[0]); err == nil {
+		if faults.Terrain == nil {
+			faults.Terrain = make(map[rover.Terrain]rover.FaultRates)
+		}
+		if faults.Terrain[terrain] == nil {
+			faults.Terrain[terrain] = make(rover.FaultRates)
+		}
+		faults.Terrain[terrain][fault] = rate
+
+		return nil
+	}
+
+	runes := []rune(strs[0])
+	if len(runes) != 1 {
+		return fmt.Errorf("one instruction or a terrain expected but got %s", strs[0])
+	}
+
+	instruction := rover.Instruction(runes[0])
+	if err := instruction.Valid(); err != nil {
+		return err
+	}
+
+	if faults.Instructions == nil {
+		faults.Instructions = make(map[rover.Instruction]rover.FaultRates)
+	}
+	if faults.Instructions[instruction] == nil {
+		faults.Instructions[instruction] = make(rover.FaultRates)
+	}
+	faults.Instructions[instruction][fault] = rate
+
+	return nil
+}
+
+func stringToFault(s string) (rover.Fault, error) {
+	switch s {
+	case "skip":
+		return rover.SkippedMove, nil
+	case "overshoot":
+		return rover.Overshoot, nil
+	case "stall":
+		return rover.Stall, nil
+	default:
+		return rover.NoFault, fmt.Errorf("unknown fault string %s", s)
+	}
+}
+
+//parseCoordinateValue parses a coordinate followed by a value, such as its height or the accuracy of a landmark, e.g.
+//"1 2 0.5".
+func parseCoordinateValue(strs []string) (rover.Coordinate, float64, error) {
+	coordinate, err := parseCoordinate(strs)
+	if err != nil {
+		return rover.Coordinate{}, 0, err
+	}
+
+	height, err := strconv.ParseFloat(strs[2], 64)
+	if err != nil {
+		return rover.Coordinate{}, 0, err
+	}
+
+	return coordinate, height, nil
+}
+
+//parseClock parses the length of a sol in ticks followed by the charge gained each tick in full sunlight and the
+//charge drained each tick in darkness, e.g. "24 0.5 0.25".
+func parseClock(strs []string) (*rover.Clock, error) {
+	solLength, err := strconv.Atoi(strs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	chargeRate, err := strconv.ParseFloat(strs[1], 64)
+	if err != nil {
+		return nil, err
+	}
+
+	nightDrain, err := strconv.ParseFloat(strs[2], 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rover.Clock{SolLength: solLength, ChargeRate: chargeRate, NightDrain: nightDrain}, nil
+}
+
+func stringToMode(s string) (rover.Mode, error) {
+	switch s {
+	case "sequential":
+		return rover.Sequential, nil
+	case "lockstep":
+		return rover.Lockstep, nil
+	default:
+		return rover.Sequential, fmt.Errorf("unknown mode string %s", s)
+	}
+}
+
+func stringToGrid(s string) (rover.Grid, error) {
+	switch s {
+	case "square":
+		return rover.Square, nil
+	case "hex":
+		return rover.Hex, nil
+	default:
+		return rover.Square, fmt.Errorf("unknown grid string %s", s)
+	}
+}
+
+func stringToTopology(s string) (rover.Topology, error) {
+	switch s {
+	case "bounded":
+		return rover.Bounded, nil
+	case "world":
+		return rover.World, nil
+	default:
+		return rover.Bounded, fmt.Errorf("unknown topology string %s", s)
+	}
+}
+
+func stringToRightOfWay(s string) (rover.RightOfWay, error) {
+	switch s {
+	case "first":
+		return rover.FirstDeployed, nil
+	case "last":
+		return rover.LastDeployed, nil
+	case "most-remaining":
+		return rover.MostRemaining, nil
+	default:
+		return rover.FirstDeployed, fmt.Errorf("unknown right of way string %s", s)
+	}
+}
@@ -0,0 +1,196 @@
+package parser
+
+import (
+	"fmt"
+	"github.com/mikey-wotton/go-mars-rover/rover"
+	"strconv"
+	"strings"
+)
+
+//modelOptions are the rover options which may also describe a model.
+var modelOptions = map[string]bool{
+	"battery": true,
+	"camera":  true,
+	"slope":   true,
+	"terrain": true,
+}
+
+const (
+	optionSeparator    = "="
+	chargeSeparator    = "/"
+	traversalSeparator = ":"
+)
+
+//parseRoverOption parses an option from the end of a rover's position line, such as "battery=80/100", onto the rover.
+//A rover's model must be declared by a model directive before the rover.
+func parseRoverOption(r *rover.Rover, option string, models map[string]*rover.Model) error {
+	strs := strings.SplitN(option, optionSeparator, 2)
+	if len(strs) != 2 {
+		return fmt.Errorf("%w %s", ErrInvalidRoverOption, option)
+	}
+
+	var err error
+	switch strs[0] {
+	case "battery":
+		r.Battery, err = parseBattery(strs[1])
+	case "slope":
+		r.MaxSlope, err = strconv.ParseFloat(strs[1], 64)
+	case "terrain":
+		err = parseTraversal(r, strs[1])
+	case "camera":
+		r.Camera, err = parseCamera(strs[1])
+	case "model":
+		model, ok := models[strs[1]]
+		if !ok {
+			return fmt.Errorf("%w %s", ErrUnknownModel, strs[1])
+		}
+		model.Deploy(r)
+	case "storage":
+		var capacity int
+		capacity, err = strconv.Atoi(strs[1])
+		r.Payload = &rover.Payload{Capacity: capacity}
+	case "odometry":
+		var drift float64
+		drift, err = strconv.ParseFloat(strs[1], 64)
+		r.Odometry = rover.NewOdometry(*r.Position, drift)
+	default:
+		return fmt.Errorf("%w %s", ErrUnknownRoverOption, strs[0])
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w %s : %v", ErrInvalidRoverOption, option, err)
+	}
+
+	return nil
+}
+
+//parseBattery parses a battery's charge and capacity, e.g. "80/100", or just its capacity when it is fully charged.
+func parseBattery(s string) (*rover.Battery, error) {
+	strs := strings.SplitN(s, chargeSeparator, 2)
+
+	charge, err := strconv.ParseFloat(strs[0], 64)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := charge
+	if len(strs) == 2 {
+		capacity, err = strconv.ParseFloat(strs[1], 64)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &rover.Battery{
+		Capacity: capacity,
+		Charge:   charge,
+	}, nil
+}
+
+//parseTraversal parses how the rover crosses a terrain, its name and cost optionally followed by "impassable" or
+//"slide", e.g. "sand:2" or "ice:0:impassable".
+func parseTraversal(r *rover.Rover, s string) error {
+	strs := strings.Split(s, traversalSeparator)
+	if len(strs) < 2 || len(strs) > 3 {
+		return fmt.Errorf("terrain and cost expected but got %s", s)
+	}
+
+	terrain, err := stringToTerrain(strs[0])
+	if err != nil {
+		return err
+	}
+
+	var traversal rover.Traversal
+	traversal.Cost, err = strconv.ParseFloat(strs[1], 64)
+	if err != nil {
+		return err
+	}
+
+	if len(strs) == 3 {
+		switch strs[2] {
+		case "impassable":
+			traversal.Impassable = true
+		case "slide":
+			traversal.Slide = true
+		default:
+			return fmt.Errorf("unknown terrain rule %s", strs[2])
+		}
+	}
+
+	if r.Traversal == nil {
+		r.Traversal = make(map[rover.Terrain]rover.Traversal)
+	}
+	r.Traversal[terrain] = traversal
+
+	return nil
+}
+
+func stringToTerrain(s string) (rover.Terrain, error) {
+	switch s {
+	case "ground":
+		return rover.Ground, nil
+	case "sand":
+		return rover.Sand, nil
+	case "rock":
+		return rover.Rock, nil
+	case "ice":
+		return rover.Ice, nil
+	default:
+		return rover.Ground, fmt.Errorf("unknown terrain string %s", s)
+	}
+}
+
+//parseCamera parses a camera's range and angle in degrees, e.g. "3/90".
+func parseCamera(s string) (*rover.Camera, error) {
+	strs := strings.SplitN(s, chargeSeparator, 2)
+	if len(strs) != 2 {
+		return nil, fmt.Errorf("range and angle expected but got %s", s)
+	}
+
+	cameraRange, err := strconv.Atoi(strs[0])
+	if err != nil {
+		return nil, err
+	}
+
+	angle, err := strconv.ParseFloat(strs[1], 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rover.Camera{Range: cameraRange, Angle: angle}, nil
+}
+
+//parseModel parses a model's name followed by its options, e.g. "scout stride=2 instructions=MRW battery=50". Besides
+//its stride and the instructions it supports, a model takes the battery, camera, slope and terrain rover options.
+func parseModel(strs []string) (*rover.Model, error) {
+	model := &rover.Model{Name: strs[0]}
+
+	//the specification shared with rover options is parsed onto a rover and then copied to the model
+	spec := &rover.Rover{}
+	for _, option := range strs[1:] {
+		kv := strings.SplitN(option, optionSeparator, 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("%w %s", ErrInvalidRoverOption, option)
+		}
+
+		var err error
+		switch {
+		case kv[0] == "stride":
+			model.Stride, err = strconv.Atoi(kv[1])
+		case kv[0] == "instructions":
+			model.Instructions, err = rover.DecodeCommands(kv[1])
+		case modelOptions[kv[0]]:
+			err = parseRoverOption(spec, option, nil)
+		default:
+			return nil, fmt.Errorf("%w %s", ErrUnknownRoverOption, kv[0])
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	model.Battery, model.Camera, model.MaxSlope, model.Traversal = spec.Battery, spec.Camera, spec.MaxSlope, spec.Traversal
+
+	return model, model.Valid()
+}
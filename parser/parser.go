@@ -2,6 +2,7 @@ package parser
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"github.com/mikey-wotton/go-mars-rover/rover"
@@ -12,19 +13,53 @@ import (
 var (
 	ErrEmptyInput               = errors.New("input is empty")
 	ErrRoverWithoutInstructions = errors.New("rover missing instructions")
-	ErrBoundariesNotProvided    = errors.New("two boundaries are required")
+	ErrBoundariesNotProvided    = errors.New("two or four boundaries are required")
 	ErrInvalidBoundary          = errors.New("invalid boundary provided")
 	ErrRoverInitialise          = errors.New("rover initialise not provided x, y, and direction")
+	ErrUnknownDirective         = errors.New("unknown mission directive")
+	ErrInvalidDirective         = errors.New("invalid mission directive")
+	ErrInvalidRoverName         = errors.New("invalid rover name")
+	ErrUnknownRoverOption       = errors.New("unknown rover option")
+	ErrInvalidRoverOption       = errors.New("invalid rover option")
+	ErrUnknownModel             = errors.New("unknown rover model")
 )
 
 const (
 	numBoundaries      = 2 //X, Y
+	numCorners         = 4 //lower-left X, Y, and upper-right X, Y
 	numRoverInitValues = 3 //X, Y, and Direction
+
+	directivePrefix = "@"
+	nameSuffix      = ":"
 )
 
 //ParseInstructions takes in a string and returns a slice of rovers with the provided positions and instructions.
 //If it encounters any error in parsing the string, it will return an error stating so and not continue to the next rover.
 func ParseInstructions(input string) (rover.Rovers, error) {
+	return ParseInstructionsContext(context.Background(), input)
+}
+
+//ParseInstructionsContext behaves like ParseInstructions but checks ctx before parsing each rover, returning the
+//context's error if it is cancelled.
+func ParseInstructionsContext(ctx context.Context, input string) (rover.Rovers, error) {
+	mission, err := ParseMissionContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return mission.Rovers, nil
+}
+
+//ParseMission takes in a string and returns a Mission holding the rovers with the provided positions and instructions.
+//Alongside the rovers, the input may contain directive lines such as "@mode lockstep" which configure the mission.
+//If it encounters any error in parsing the string, it will return an error stating so and not continue to the next rover.
+func ParseMission(input string) (*rover.Mission, error) {
+	return ParseMissionContext(context.Background(), input)
+}
+
+//ParseMissionContext behaves like ParseMission but checks ctx before parsing each rover, returning the context's error
+//if it is cancelled.
+func ParseMissionContext(ctx context.Context, input string) (*rover.Mission, error) {
 	scanner := bufio.NewScanner(strings.NewReader(input))
 
 	if !scanner.Scan() {
@@ -34,14 +69,28 @@ func ParseInstructions(input string) (rover.Rovers, error) {
 
 		return nil, ErrEmptyInput
 	}
-	boundary, err := parseBoundary(scanner)
+	origin, boundary, err := parseBoundary(scanner)
 	if err != nil {
 		return nil, err
 	}
 
-	rovers := make(rover.Rovers, 0)
+	mission := &rover.Mission{
+		Rovers: make(rover.Rovers, 0),
+	}
+	state := &missionState{mission: mission, origin: origin}
 	for scanner.Scan() {
-		position, err := parseRoverPosition(scanner)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if strings.HasPrefix(scanner.Text(), directivePrefix) {
+			if err := parseDirective(scanner, state); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		r, err := parseRover(scanner, state.models)
 		if err != nil {
 			return nil, err
 		}
@@ -49,53 +98,81 @@ func ParseInstructions(input string) (rover.Rovers, error) {
 		if !scanner.Scan() {
 			return nil, ErrRoverWithoutInstructions
 		}
-		instructions := scanner.Text()
-
-		rover := &rover.Rover{
-			Boundary: boundary,
-			Commands: instructions,
-			Position: position,
+		r.Commands = scanner.Text()
+		r.Grid = state.grid
+		r.Topology = state.topology
+		r.Origin = origin
+		r.Boundary = boundary
+
+		if r.Name != "" && mission.Rovers.Index(r.Name) >= 0 {
+			return nil, fmt.Errorf("%w : %s", rover.ErrDuplicateRoverName, r.Name)
 		}
 
-		if err := rover.Valid(); err != nil {
+		if err := r.Valid(); err != nil {
 			return nil, err
 		}
 
-		rovers = append(rovers, rover)
+		mission.Rovers = append(mission.Rovers, r)
+	}
+
+	//directives may follow the rovers they describe, so anything shared is only checked once every rover is parsed
+	if state.share() {
+		if err := mission.Rovers.Valid(); err != nil {
+			return nil, err
+		}
 	}
 
-	return rovers, nil
+	return mission, nil
 }
 
-func parseBoundary(scanner *bufio.Scanner) (*rover.Coordinate, error) {
+//parseBoundary parses the plateau's first line, either the upper-right corner alone with the lower-left at (0, 0),
+//e.g. "5 5", or the lower-left corner followed by the upper-right, e.g. "-10 -10 10 10".
+func parseBoundary(scanner *bufio.Scanner) (rover.Coordinate, *rover.Coordinate, error) {
 	line := scanner.Text()
 
 	strs := strings.Split(line, " ")
-	if len(strs) != numBoundaries {
-		return nil, ErrBoundariesNotProvided
+	if len(strs) != numBoundaries && len(strs) != numCorners {
+		return rover.Coordinate{}, nil, ErrBoundariesNotProvided
 	}
 
-	boundX, err := strconv.Atoi(strs[0])
-	if err != nil {
-		return nil, ErrInvalidBoundary
+	values := make([]int, len(strs))
+	for i, str := range strs {
+		value, err := strconv.Atoi(str)
+		if err != nil {
+			return rover.Coordinate{}, nil, ErrInvalidBoundary
+		}
+		values[i] = value
 	}
 
-	boundY, err := strconv.Atoi(strs[1])
-	if err != nil {
-		return nil, ErrInvalidBoundary
+	var origin rover.Coordinate
+	if len(values) == numCorners {
+		origin = rover.Coordinate{X: values[0], Y: values[1]}
+		values = values[numBoundaries:]
 	}
 
-	return &rover.Coordinate{
-		X: boundX,
-		Y: boundY,
+	return origin, &rover.Coordinate{
+		X: values[0],
+		Y: values[1],
 	}, nil
 }
 
-func parseRoverPosition(scanner *bufio.Scanner) (*rover.Position, error) {
+//parseRover parses a rover's position line, which may begin with the rover's name and end with options,
+//e.g. "Spirit: 1 2 North battery=80/100".
+func parseRover(scanner *bufio.Scanner, models map[string]*rover.Model) (*rover.Rover, error) {
 	line := scanner.Text()
 
 	strs := strings.Split(line, " ")
-	if len(strs) != numRoverInitValues {
+
+	var name string
+	if strings.HasSuffix(strs[0], nameSuffix) {
+		name = strings.TrimSuffix(strs[0], nameSuffix)
+		if name == "" {
+			return nil, fmt.Errorf("%w : %s", ErrInvalidRoverName, line)
+		}
+		strs = strs[1:]
+	}
+
+	if len(strs) < numRoverInitValues {
 		return nil, ErrRoverInitialise
 	}
 
@@ -114,13 +191,24 @@ func parseRoverPosition(scanner *bufio.Scanner) (*rover.Position, error) {
 		return nil, err
 	}
 
-	return &rover.Position{
-		Coordinate: rover.Coordinate{
-			X: posX,
-			Y: posY,
+	r := &rover.Rover{
+		Name: name,
+		Position: &rover.Position{
+			Coordinate: rover.Coordinate{
+				X: posX,
+				Y: posY,
+			},
+			Direction: dir,
 		},
-		Direction: dir,
-	}, nil
+	}
+
+	for _, option := range strs[numRoverInitValues:] {
+		if err := parseRoverOption(r, option, models); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
 }
 
 func stringToDirection(s string) (rover.Direction, error) {
@@ -135,9 +223,31 @@ func stringToDirection(s string) (rover.Direction, error) {
 		dir = rover.South
 	case "West":
 		dir = rover.West
+	case "NorthEast":
+		dir = rover.NorthEast
+	case "SouthEast":
+		dir = rover.SouthEast
+	case "SouthWest":
+		dir = rover.SouthWest
+	case "NorthWest":
+		dir = rover.NorthWest
 	default:
 		return rover.UnknownDirection, fmt.Errorf("unknown direction string %s", s)
 	}
 
 	return dir, nil
-}
\ No newline at end of file
+}
+
+func parseCoordinate(strs []string) (rover.Coordinate, error) {
+	x, err := strconv.Atoi(strs[0])
+	if err != nil {
+		return rover.Coordinate{}, err
+	}
+
+	y, err := strconv.Atoi(strs[1])
+	if err != nil {
+		return rover.Coordinate{}, err
+	}
+
+	return rover.Coordinate{X: x, Y: y}, nil
+}
@@ -1,9 +1,11 @@
 package parser
 
 import (
+	"context"
 	"fmt"
 	"github.com/mikey-wotton/go-mars-rover/rover"
 	"github.com/stretchr/testify/assert"
+	"strconv"
 	"testing"
 )
 
@@ -81,19 +83,35 @@ MMRMMRMRRM`,
 			},
 			expErr: nil,
 		},
+		"rover with wait durations": {
+			input: `1 1
+0 0 South
+LW2M`,
+			expRovers: rover.Rovers{
+				&rover.Rover{
+					Boundary: &rover.Coordinate{X: 1, Y: 1},
+					Commands: "LW2M",
+					Position: &rover.Position{
+						Coordinate: rover.Coordinate{},
+						Direction:  rover.South,
+					},
+				},
+			},
+			expErr: nil,
+		},
 		"err rover outside X boundary": {
 			input: `1 1
 2 1 North
 LLLMMMRRR`,
 			expRovers: nil,
-			expErr:    rover.ErrRoverOutsideXBoundary,
+			expErr:    &rover.ValidationError{Err: rover.ErrRoverOutsideXBoundary},
 		},
 		"err rover outside Y boundary": {
 			input: `1 1
 1 2 North
 LLLMMMRRR`,
 			expRovers: nil,
-			expErr:    rover.ErrRoverOutsideYBoundary,
+			expErr:    &rover.ValidationError{Err: rover.ErrRoverOutsideYBoundary},
 		},
 		"err rover invalid direction": {
 			input: `1 1
@@ -112,7 +130,11 @@ LLLMMMRRR`,
 0 0 South
 LRMX`,
 			expRovers: nil,
-			expErr:    fmt.Errorf("rover provided unknown Instruction{%d}", 'X'),
+			expErr: &rover.ValidationError{Err: &rover.InvalidInstructionError{
+				Step:        3,
+				Instruction: 'X',
+				Err:         fmt.Errorf("%w{%d}", rover.ErrUnknownInstruction, 'X'),
+			}},
 		},
 		"err rover without instructions": {
 			input: `1 1
@@ -124,7 +146,981 @@ LRMX`,
 
 	for description, test := range tests {
 		rovers, err := ParseInstructions(test.input)
+		err = withoutRover(err)
 		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
 		assert.ElementsMatchf(t, test.expRovers, rovers, "%s failed, expected rovers %v but got %v", description, test.expRovers, rovers)
 	}
 }
+
+//withoutRover clears the rover the parser built from validation errors, so they can be compared against the expected
+//error which cannot reference it.
+func withoutRover(err error) error {
+	switch e := err.(type) {
+	case *rover.InvalidInstructionError:
+		e.Rover = nil
+	case *rover.ValidationError:
+		e.Rover = nil
+		withoutRover(e.Err)
+	}
+
+	return err
+}
+
+func TestParseMission(t *testing.T) {
+	tests := map[string]struct {
+		input         string
+		expMode       rover.Mode
+		expRightOfWay rover.RightOfWay
+		expRovers     int
+		expErr        error
+	}{
+		"mission defaults to sequential": {
+			input: `5 5
+1 2 North
+LMLMLMLMM`,
+			expMode:       rover.Sequential,
+			expRightOfWay: rover.FirstDeployed,
+			expRovers:     1,
+		},
+		"mission with lockstep mode and right of way directives": {
+			input: `5 5
+@mode lockstep
+@rightofway most-remaining
+1 2 North
+LMLMLMLMM
+3 3 East
+MMRMMRMRRM`,
+			expMode:       rover.Lockstep,
+			expRightOfWay: rover.MostRemaining,
+			expRovers:     2,
+		},
+		"err mission with unknown directive": {
+			input: `5 5
+@speed fast
+1 2 North
+LMLMLMLMM`,
+			expErr: fmt.Errorf("%w %s", ErrUnknownDirective, "speed"),
+		},
+		"err mission with directive missing a value": {
+			input: `5 5
+@mode
+1 2 North
+LMLMLMLMM`,
+			expErr: fmt.Errorf("%w %s", ErrInvalidDirective, "@mode"),
+		},
+		"err mission with unknown mode": {
+			input: `5 5
+@mode sideways
+1 2 North
+LMLMLMLMM`,
+			expErr: fmt.Errorf("unknown mode string %s", "sideways"),
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			assert.Nilf(t, mission, "%s failed, expected nil mission but got %v", description, mission)
+			continue
+		}
+		assert.Equalf(t, test.expMode, mission.Mode, "%s failed, expected mode %d but got %d", description, test.expMode, mission.Mode)
+		assert.Equalf(t, test.expRightOfWay, mission.RightOfWay, "%s failed, expected right of way %d but got %d", description, test.expRightOfWay, mission.RightOfWay)
+		assert.Lenf(t, mission.Rovers, test.expRovers, "%s failed, expected %d rovers but got %d", description, test.expRovers, len(mission.Rovers))
+	}
+}
+
+func TestParseMission_Origin(t *testing.T) {
+	tests := map[string]struct {
+		input       string
+		expOrigin   rover.Coordinate
+		expBoundary *rover.Coordinate
+		expTerrain  map[rover.Coordinate]rover.Terrain
+		expErr      error
+	}{
+		"upper-right corner alone starts at zero": {
+			input: `5 5
+1 2 North
+M`,
+			expBoundary: &rover.Coordinate{X: 5, Y: 5},
+		},
+		"lower-left and upper-right corners": {
+			input: `-10 -10 10 10
+-3 -7 West
+MMLM`,
+			expOrigin:   rover.Coordinate{X: -10, Y: -10},
+			expBoundary: &rover.Coordinate{X: 10, Y: 10},
+		},
+		"terrain map anchored at the origin": {
+			input: `-2 -1 0 0
+@terrain 2
+s..
+..i
+0 0 South
+M`,
+			expOrigin:   rover.Coordinate{X: -2, Y: -1},
+			expBoundary: &rover.Coordinate{X: 0, Y: 0},
+			expTerrain:  map[rover.Coordinate]rover.Terrain{{X: -2, Y: 0}: rover.Sand, {X: 0, Y: -1}: rover.Ice},
+		},
+		"err three boundaries": {
+			input: `-10 -10 10
+0 0 North
+M`,
+			expErr: ErrBoundariesNotProvided,
+		},
+		"err non numeric corner": {
+			input: `-10 south 10 10
+0 0 North
+M`,
+			expErr: ErrInvalidBoundary,
+		},
+		"err rover below the origin": {
+			input: `-10 -10 10 10
+0 -11 North
+M`,
+			expErr: &rover.ValidationError{Err: rover.ErrRoverOutsideYBoundary},
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		err = withoutRover(err)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		r := mission.Rovers[0]
+		assert.Equalf(t, test.expOrigin, r.Origin, "%s failed, expected origin %v but got %v", description, test.expOrigin, r.Origin)
+		assert.Equalf(t, test.expBoundary, r.Boundary, "%s failed, expected boundary %v but got %v", description, test.expBoundary, r.Boundary)
+		if test.expTerrain != nil {
+			assert.Equalf(t, test.expTerrain, r.Plateau.Terrain, "%s failed, expected terrain %v but got %v", description, test.expTerrain, r.Plateau.Terrain)
+		}
+	}
+}
+
+func TestParseMission_Shape(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expShape rover.Shape
+		expErr   error
+	}{
+		"polygon directive": {
+			input: `4 4
+@polygon 0 0 4 0 0 4
+1 1 North
+M`,
+			expShape: rover.Polygon{{X: 0, Y: 0}, {X: 4, Y: 0}, {X: 0, Y: 4}},
+		},
+		"mask directive anchored at the origin": {
+			input: `-1 -1 1 0
+@mask 2
+.o.
+ooo
+0 -1 North
+M`,
+			expShape: rover.Mask{{X: 0, Y: 0}: true, {X: -1, Y: -1}: true, {X: 0, Y: -1}: true, {X: 1, Y: -1}: true},
+		},
+		"err polygon with an odd number of values": {
+			input: `4 4
+@polygon 0 0 4 0 0
+1 1 North
+M`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@polygon 0 0 4 0 0",
+				fmt.Errorf("polygon vertices need an x and y but got %d values", 5)),
+		},
+		"err polygon with two vertices": {
+			input: `4 4
+@polygon 0 0 4 4
+1 1 North
+M`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@polygon 0 0 4 4",
+				fmt.Errorf("%w : polygon has %d vertices", rover.ErrInvalidShape, 2)),
+		},
+		"err rover outside the polygon": {
+			input: `4 4
+@polygon 0 0 4 0 0 4
+3 3 North
+M`,
+			expErr: &rover.ValidationError{Err: &rover.OffPlateauError{Coordinate: rover.Coordinate{X: 3, Y: 3}, Err: rover.ErrOffPlateau}},
+		},
+		"err mask ended early": {
+			input: `2 2
+@mask 3
+ooo
+ooo`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@mask 3", fmt.Errorf("mask ended %d rows early", 1)),
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		err = withoutRover(err)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		shape := mission.Rovers[0].Plateau.Shape
+		assert.Equalf(t, test.expShape, shape, "%s failed, expected shape %v but got %v", description, test.expShape, shape)
+	}
+}
+
+func TestParseMission_Geofences(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		expFences []rover.Geofence
+		expErr    error
+	}{
+		"rectangle and polygon fences with windows": {
+			input: `5 5
+@fence lander 2 2 3 3
+@fence dust 0 4 4 4 4 5 ticks=3-10
+@fence blast 0 0 1 1 ticks=-2
+1 1 North
+M`,
+			expFences: []rover.Geofence{
+				{Name: "lander", Shape: rover.Rectangle{Min: rover.Coordinate{X: 2, Y: 2}, Max: rover.Coordinate{X: 3, Y: 3}}},
+				{Name: "dust", Shape: rover.Polygon{{X: 0, Y: 4}, {X: 4, Y: 4}, {X: 4, Y: 5}}, Start: 3, End: 10},
+				{Name: "blast", Shape: rover.Rectangle{Min: rover.Coordinate{X: 0, Y: 0}, Max: rover.Coordinate{X: 1, Y: 1}}, End: 2},
+			},
+		},
+		"err ticks without a range": {
+			input: `5 5
+@fence blast 0 0 1 1 ticks=3
+1 1 North
+M`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@fence blast 0 0 1 1 ticks=3",
+				fmt.Errorf("geofence ticks must be start-end but got %s", "ticks=3")),
+		},
+		"err fence ends before it starts": {
+			input: `5 5
+@fence blast 0 0 1 1 ticks=4-2
+1 1 North
+M`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@fence blast 0 0 1 1 ticks=4-2",
+				fmt.Errorf("%w : %s from tick %d to %d", rover.ErrInvalidGeofence, "blast", 4, 2)),
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		fences := mission.Rovers[0].Plateau.Geofences
+		assert.Equalf(t, test.expFences, fences, "%s failed, expected fences %v but got %v", description, test.expFences, fences)
+	}
+}
+
+func TestParseMission_Hazards(t *testing.T) {
+	tests := map[string]struct {
+		input      string
+		expHazards []rover.Hazard
+		expErr     error
+	}{
+		"hazards with and without repeating paths": {
+			input: `5 5
+@hazard dust 0 4 1 4 2 4 repeat
+@hazard rockfall 5 5 5 4
+1 1 North
+M`,
+			expHazards: []rover.Hazard{
+				{Name: "dust", Path: []rover.Coordinate{{X: 0, Y: 4}, {X: 1, Y: 4}, {X: 2, Y: 4}}, Repeat: true},
+				{Name: "rockfall", Path: []rover.Coordinate{{X: 5, Y: 5}, {X: 5, Y: 4}}},
+			},
+		},
+		"err hazard without a path": {
+			input: `5 5
+@hazard dust repeat
+1 1 North
+M`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@hazard dust repeat",
+				fmt.Errorf("%w : %s has no path", rover.ErrInvalidHazard, "dust")),
+		},
+		"err hazard path with an odd number of values": {
+			input: `5 5
+@hazard dust 0 4 1
+1 1 North
+M`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@hazard dust 0 4 1",
+				fmt.Errorf("hazard path needs an x and y on each tick but got %d values", 3)),
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		hazards := mission.Rovers[0].Plateau.Hazards
+		assert.Equalf(t, test.expHazards, hazards, "%s failed, expected hazards %v but got %v", description, test.expHazards, hazards)
+	}
+}
+
+func TestParseMission_Grid(t *testing.T) {
+	tests := map[string]struct {
+		input       string
+		expGrid     rover.Grid
+		expPosition rover.Position
+		expErr      error
+	}{
+		"square grid by default": {
+			input: `5 5
+1 1 North
+M`,
+			expGrid:     rover.Square,
+			expPosition: rover.Position{Coordinate: rover.Coordinate{X: 1, Y: 2}, Direction: rover.North},
+		},
+		"hex grid with a diagonal heading": {
+			input: `5 5
+@grid hex
+1 1 NorthEast
+RM`,
+			expGrid:     rover.Hex,
+			expPosition: rover.Position{Coordinate: rover.Coordinate{X: 2, Y: 1}, Direction: rover.East},
+		},
+		"err grid after a rover": {
+			input: `5 5
+1 1 North
+M
+@grid hex`,
+			expErr: fmt.Errorf("%w %s : grid must be set before any rover", ErrInvalidDirective, "@grid hex"),
+		},
+		"err unknown grid": {
+			input: `5 5
+@grid triangle
+1 1 North
+M`,
+			expErr: fmt.Errorf("unknown grid string %s", "triangle"),
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		r := mission.Rovers[0]
+		assert.Equalf(t, test.expGrid, r.Grid, "%s failed, expected grid %d but got %d", description, test.expGrid, r.Grid)
+		if assert.Nilf(t, r.Explore(), "%s failed, expected the rover to explore", description) {
+			assert.Equalf(t, test.expPosition, *r.Position, "%s failed, expected position %v but got %v", description, test.expPosition, *r.Position)
+		}
+	}
+}
+
+func TestParseMission_Topology(t *testing.T) {
+	tests := map[string]struct {
+		input       string
+		expTopology rover.Topology
+		expPosition rover.Position
+		expErr      error
+	}{
+		"bounded by default": {
+			input: `3 2
+1 1 East
+M`,
+			expTopology: rover.Bounded,
+			expPosition: rover.Position{Coordinate: rover.Coordinate{X: 2, Y: 1}, Direction: rover.East},
+		},
+		"world wraps over the pole": {
+			input: `3 2
+@topology world
+1 2 North
+M`,
+			expTopology: rover.World,
+			expPosition: rover.Position{Coordinate: rover.Coordinate{X: 3, Y: 2}, Direction: rover.South},
+		},
+		"err topology after a rover": {
+			input: `3 2
+1 1 East
+M
+@topology world`,
+			expErr: fmt.Errorf("%w %s : topology must be set before any rover", ErrInvalidDirective, "@topology world"),
+		},
+		"err unknown topology": {
+			input: `3 2
+@topology torus
+1 1 East
+M`,
+			expErr: fmt.Errorf("unknown topology string %s", "torus"),
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		r := mission.Rovers[0]
+		assert.Equalf(t, test.expTopology, r.Topology, "%s failed, expected topology %d but got %d", description, test.expTopology, r.Topology)
+		if assert.Nilf(t, r.Explore(), "%s failed, expected the rover to explore", description) {
+			assert.Equalf(t, test.expPosition, *r.Position, "%s failed, expected position %v but got %v", description, test.expPosition, *r.Position)
+		}
+	}
+}
+
+func TestParseMissionContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mission, err := ParseMissionContext(ctx, `5 5
+1 2 North
+LMLMLMLMM`)
+	assert.Equalf(t, context.Canceled, err, "expected error %v but got %v", context.Canceled, err)
+	assert.Nilf(t, mission, "expected nil mission but got %v", mission)
+}
+
+func TestParseInstructions_Names(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expNames []string
+		expErr   error
+	}{
+		"rovers may be named": {
+			input: `5 5
+Spirit: 1 2 North
+LMLMLMLMM
+Opportunity: 3 3 East
+MMRMMRMRRM`,
+			expNames: []string{"Spirit", "Opportunity"},
+		},
+		"named and unnamed rovers may be mixed": {
+			input: `5 5
+1 2 North
+LMLMLMLMM
+Opportunity: 3 3 East
+MMRMMRMRRM`,
+			expNames: []string{"", "Opportunity"},
+		},
+		"err rover names must be unique": {
+			input: `5 5
+Spirit: 1 2 North
+LMLMLMLMM
+Spirit: 3 3 East
+MMRMMRMRRM`,
+			expErr: fmt.Errorf("%w : %s", rover.ErrDuplicateRoverName, "Spirit"),
+		},
+		"err rover name must not be empty": {
+			input: `5 5
+: 1 2 North
+LMLMLMLMM`,
+			expErr: fmt.Errorf("%w : %s", ErrInvalidRoverName, ": 1 2 North"),
+		},
+		"err named rover missing direction": {
+			input: `5 5
+Spirit: 1 2
+LMLMLMLMM`,
+			expErr: ErrRoverInitialise,
+		},
+	}
+
+	for description, test := range tests {
+		rovers, err := ParseInstructions(test.input)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err == nil {
+			assert.Equalf(t, test.expNames, rovers.Names(), "%s failed, expected names %v but got %v", description, test.expNames, rovers.Names())
+		}
+	}
+}
+
+func TestParseMission_Obstacles(t *testing.T) {
+	tests := map[string]struct {
+		input        string
+		expObstacles map[rover.Coordinate]bool
+		expErr       error
+	}{
+		"rovers without obstacles have no plateau": {
+			input: `5 5
+1 2 North
+LMLMLMLMM`,
+		},
+		"rovers share the plateau holding the obstacles": {
+			input: `5 5
+@obstacle 0 0
+@obstacle 4 4
+1 2 North
+LMLMLMLMM
+3 3 East
+MMRMMRMRRM`,
+			expObstacles: map[rover.Coordinate]bool{{X: 0, Y: 0}: true, {X: 4, Y: 4}: true},
+		},
+		"err obstacle with invalid coordinate": {
+			input: `5 5
+@obstacle 0 north
+1 2 North
+LMLMLMLMM`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@obstacle 0 north", &strconv.NumError{Func: "Atoi", Num: "north", Err: strconv.ErrSyntax}),
+		},
+		"err rover starting on an obstacle": {
+			input: `5 5
+1 2 North
+LMLMLMLMM
+@obstacle 1 2`,
+			expErr: &rover.ValidationError{Err: rover.ErrRoverOnObstacle},
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		err = withoutRover(err)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		for _, r := range mission.Rovers {
+			if test.expObstacles == nil {
+				assert.Nilf(t, r.Plateau, "%s failed, expected no plateau but got %v", description, r.Plateau)
+				continue
+			}
+			assert.Samef(t, mission.Rovers[0].Plateau, r.Plateau, "%s failed, expected rovers to share a plateau", description)
+			assert.Equalf(t, test.expObstacles, r.Plateau.Obstacles, "%s failed, expected obstacles %v but got %v", description, test.expObstacles, r.Plateau.Obstacles)
+		}
+	}
+}
+
+func TestParseMission_Battery(t *testing.T) {
+	tests := map[string]struct {
+		input      string
+		expBattery []*rover.Battery
+		expErr     error
+	}{
+		"rovers without a battery option have no battery": {
+			input: `5 5
+1 2 North
+LMLMLMLMM`,
+			expBattery: []*rover.Battery{nil},
+		},
+		"battery with charge and capacity, and a full battery": {
+			input: `5 5
+Spirit: 1 2 North battery=80/100
+LMLMLMLMM
+3 3 East battery=50
+MMRMMRMRRM`,
+			expBattery: []*rover.Battery{
+				{Capacity: 100, Charge: 80},
+				{Capacity: 50, Charge: 50},
+			},
+		},
+		"cost directives are shared by every battery": {
+			input: `5 5
+1 2 North battery=10
+LMLMLMLMM
+@cost M 2
+@cost W 0.5`,
+			expBattery: []*rover.Battery{
+				{Capacity: 10, Charge: 10, Costs: map[rover.Instruction]float64{rover.Move: 2, rover.Wait: 0.5}},
+			},
+		},
+		"err unknown rover option": {
+			input: `5 5
+1 2 North fuel=10
+LMLMLMLMM`,
+			expErr: fmt.Errorf("%w %s", ErrUnknownRoverOption, "fuel"),
+		},
+		"err rover option without a value": {
+			input: `5 5
+1 2 North battery
+LMLMLMLMM`,
+			expErr: fmt.Errorf("%w %s", ErrInvalidRoverOption, "battery"),
+		},
+		"err battery charge above capacity": {
+			input: `5 5
+1 2 North battery=11/10
+LMLMLMLMM`,
+			expErr: &rover.ValidationError{Err: fmt.Errorf("%w : %g of %g", rover.ErrInvalidBattery, 11.0, 10.0)},
+		},
+		"err cost for unknown instruction": {
+			input: `5 5
+@cost X 1
+1 2 North battery=10
+LMLMLMLMM`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@cost X 1", rover.Instruction('X').Valid()),
+		},
+		"err negative cost": {
+			input: `5 5
+@cost M -1
+1 2 North battery=10
+LMLMLMLMM`,
+			expErr: &rover.ValidationError{Err: fmt.Errorf("%w : %q costs %g", rover.ErrInvalidCost, 'M', -1.0)},
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		err = withoutRover(err)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		for i, r := range mission.Rovers {
+			assert.Equalf(t, test.expBattery[i], r.Battery, "%s failed, expected battery %v but got %v", description, test.expBattery[i], r.Battery)
+		}
+	}
+}
+
+func TestParseMission_Clock(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expClock *rover.Clock
+		expErr   error
+	}{
+		"missions without a clock directive have no clock": {
+			input: `5 5
+1 2 North battery=10
+LMLMLMLMM`,
+		},
+		"clock directive sets the sol length and rates": {
+			input: `5 5
+@clock 24 0.5 0.25
+1 2 North battery=10
+LMLMLMLMM`,
+			expClock: &rover.Clock{SolLength: 24, ChargeRate: 0.5, NightDrain: 0.25},
+		},
+		"err clock with a non numeric rate": {
+			input: `5 5
+@clock 24 fast 0.25
+1 2 North battery=10
+LMLMLMLMM`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@clock 24 fast 0.25", &strconv.NumError{Func: "ParseFloat", Num: "fast", Err: strconv.ErrSyntax}),
+		},
+		"err clock without a sol length": {
+			input: `5 5
+@clock 0 0.5 0.25
+1 2 North battery=10
+LMLMLMLMM`,
+			expErr: fmt.Errorf("%w : sol length %d, start %d, charge rate %g, night drain %g", rover.ErrInvalidClock, 0, 0, 0.5, 0.25),
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		assert.Equalf(t, test.expClock, mission.Clock, "%s failed, expected clock %v but got %v", description, test.expClock, mission.Clock)
+	}
+}
+
+func TestParseMission_Elevation(t *testing.T) {
+	input := `3 3
+@elevation 1 2 0.5
+@elevation 2 2 3
+@climb 2
+Spirit: 1 1 North battery=10 slope=1
+MM`
+
+	mission, err := ParseMission(input)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	r := mission.Rovers[0]
+	assert.Equal(t, 1.0, r.MaxSlope)
+	assert.Equal(t, 2.0, r.Battery.ClimbCost)
+	assert.Equal(t, map[rover.Coordinate]float64{{X: 1, Y: 2}: 0.5, {X: 2, Y: 2}: 3}, r.Plateau.Elevation)
+
+	_, err = ParseMission(`3 3
+@elevation 1 2 high
+1 1 North
+M`)
+	expErr := fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@elevation 1 2 high", &strconv.NumError{Func: "ParseFloat", Num: "high", Err: strconv.ErrSyntax})
+	assert.Equal(t, expErr, err)
+
+	_, err = ParseMission(`3 3
+1 1 North slope=-1
+M`)
+	err = withoutRover(err)
+	assert.Equal(t, &rover.ValidationError{Err: fmt.Errorf("%w : %g", rover.ErrInvalidSlope, -1.0)}, err)
+}
+
+func TestParseMission_Terrain(t *testing.T) {
+	tests := map[string]struct {
+		input        string
+		expTerrain   map[rover.Coordinate]rover.Terrain
+		expObstacles map[rover.Coordinate]bool
+		expTraversal map[rover.Terrain]rover.Traversal
+		expErr       error
+	}{
+		"terrain map with a legend, first row northern most": {
+			input: `2 2
+@terrain 3
+ss.
+.#r
+i..
+1 0 North terrain=sand:3 terrain=ice:0:impassable
+LL`,
+			expTerrain: map[rover.Coordinate]rover.Terrain{
+				{X: 0, Y: 2}: rover.Sand, {X: 1, Y: 2}: rover.Sand,
+				{X: 2, Y: 1}: rover.Rock,
+				{X: 0, Y: 0}: rover.Ice,
+			},
+			expObstacles: map[rover.Coordinate]bool{{X: 1, Y: 1}: true},
+			expTraversal: map[rover.Terrain]rover.Traversal{
+				rover.Sand: {Cost: 3},
+				rover.Ice:  {Impassable: true},
+			},
+		},
+		"err rover on an obstacle of the terrain map": {
+			input: `2 2
+@terrain 2
+.#r
+i..
+1 1 East
+M`,
+			expErr: &rover.ValidationError{Err: rover.ErrRoverOnObstacle},
+		},
+		"rover beside the obstacle": {
+			input: `2 2
+@terrain 2
+.#r
+i..
+0 1 East terrain=rock:1:slide
+M`,
+			expTerrain: map[rover.Coordinate]rover.Terrain{
+				{X: 2, Y: 1}: rover.Rock,
+				{X: 0, Y: 0}: rover.Ice,
+			},
+			expObstacles: map[rover.Coordinate]bool{{X: 1, Y: 1}: true},
+			expTraversal: map[rover.Terrain]rover.Traversal{rover.Rock: {Cost: 1, Slide: true}},
+		},
+		"err unknown legend": {
+			input: `2 2
+@terrain 1
+.x.
+0 1 East
+M`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@terrain 1", fmt.Errorf("unknown terrain %q at (%d, %d)", 'x', 1, 0)),
+		},
+		"err terrain map ends early": {
+			input: `2 2
+@terrain 3
+...`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@terrain 3", fmt.Errorf("terrain map ended %d rows early", 2)),
+		},
+		"err unknown terrain rule": {
+			input: `2 2
+0 1 East terrain=sand:1:sink
+M`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidRoverOption, "terrain=sand:1:sink", fmt.Errorf("unknown terrain rule %s", "sink")),
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		err = withoutRover(err)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		r := mission.Rovers[0]
+		assert.Equalf(t, test.expTerrain, r.Plateau.Terrain, "%s failed, expected terrain %v but got %v", description, test.expTerrain, r.Plateau.Terrain)
+		assert.Equalf(t, test.expObstacles, r.Plateau.Obstacles, "%s failed, expected obstacles %v but got %v", description, test.expObstacles, r.Plateau.Obstacles)
+		assert.Equalf(t, test.expTraversal, r.Traversal, "%s failed, expected traversal %v but got %v", description, test.expTraversal, r.Traversal)
+	}
+}
+
+func TestParseMission_Faults(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		expFaults *rover.Faults
+		expErr    error
+	}{
+		"missions without fault directives never fault": {
+			input: `5 5
+1 2 North
+LMLMLMLMM`,
+		},
+		"faults per instruction and per terrain with a seed": {
+			input: `5 5
+@seed 42
+@fault M skip 0.1
+@fault M stall 0.05
+@fault sand stall 0.2
+1 2 North
+LMLMLMLMM`,
+			expFaults: &rover.Faults{
+				Seed:         42,
+				Instructions: map[rover.Instruction]rover.FaultRates{rover.Move: {rover.SkippedMove: 0.1, rover.Stall: 0.05}},
+				Terrain:      map[rover.Terrain]rover.FaultRates{rover.Sand: {rover.Stall: 0.2}},
+			},
+		},
+		"err unknown fault": {
+			input: `5 5
+@fault M crash 0.1`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@fault M crash 0.1", fmt.Errorf("unknown fault string %s", "crash")),
+		},
+		"err probability above one": {
+			input: `5 5
+@fault L overshoot 2`,
+			expErr: fmt.Errorf("%w : %q", fmt.Errorf("%w : %v %g", rover.ErrInvalidFaultRate, rover.Overshoot, 2.0), 'L'),
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		assert.Equalf(t, test.expFaults, mission.Faults, "%s failed, expected faults %v but got %v", description, test.expFaults, mission.Faults)
+	}
+}
+
+func TestParseMission_Odometry(t *testing.T) {
+	mission, err := ParseMission(`3 3
+@landmark 2 2 0.5
+1 1 North odometry=0.25
+MM
+2 0 West
+M`)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, rover.NewOdometry(rover.Position{Coordinate: rover.Coordinate{X: 1, Y: 1}, Direction: rover.North}, 0.25), mission.Rovers[0].Odometry)
+	assert.Nil(t, mission.Rovers[1].Odometry)
+	assert.Equal(t, map[rover.Coordinate]float64{{X: 2, Y: 2}: 0.5}, mission.Rovers[1].Plateau.Landmarks)
+
+	_, err = ParseMission(`3 3
+1 1 North odometry=-1
+MM`)
+	err = withoutRover(err)
+	assert.Equal(t, &rover.ValidationError{Err: fmt.Errorf("%w : drift %g, uncertainty %g", rover.ErrInvalidOdometry, -1.0, 0.0)}, err)
+}
+
+func TestParseMission_Payload(t *testing.T) {
+	mission, err := ParseMission(`3 3
+@site 1 2 alpha
+@site 1 3 beta
+Spirit: 1 1 North storage=2
+MSMSP
+2 0 West
+M`)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, &rover.Payload{Capacity: 2}, mission.Rovers[0].Payload)
+	assert.Nil(t, mission.Rovers[1].Payload)
+	assert.Equal(t, map[rover.Coordinate]string{{X: 1, Y: 2}: "alpha", {X: 1, Y: 3}: "beta"}, mission.Rovers[1].Plateau.SampleSites)
+
+	_, err = ParseMission(`3 3
+1 1 North storage=-1
+S`)
+	err = withoutRover(err)
+	assert.Equal(t, &rover.ValidationError{Err: fmt.Errorf("%w : %d of %d", rover.ErrInvalidCapacity, 0, -1)}, err)
+}
+
+func TestParseMission_Models(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		expModels []*rover.Model
+		expErr    error
+	}{
+		"rovers declare models defined by directives": {
+			input: `5 5
+@model scout stride=2 instructions=MRW battery=50 camera=3/90 slope=1 terrain=sand:0:impassable
+@model hauler
+Spirit: 1 2 North model=scout
+MMRM
+3 3 East model=hauler
+LMLM
+0 0 North
+M`,
+			expModels: []*rover.Model{
+				{
+					Name:         "scout",
+					Instructions: []rover.Instruction{rover.Move, rover.TurnRight, rover.Wait},
+					Stride:       2,
+					Battery:      &rover.Battery{Capacity: 50, Charge: 50},
+					Camera:       &rover.Camera{Range: 3, Angle: 90},
+					MaxSlope:     1,
+					Traversal:    map[rover.Terrain]rover.Traversal{rover.Sand: {Impassable: true}},
+				},
+				{Name: "hauler"},
+				nil,
+			},
+		},
+		"err unknown model": {
+			input: `5 5
+1 2 North model=scout
+MMRM`,
+			expErr: fmt.Errorf("%w %s", ErrUnknownModel, "scout"),
+		},
+		"err model without a name": {
+			input: `5 5
+@model`,
+			expErr: fmt.Errorf("%w %s", ErrInvalidDirective, "@model"),
+		},
+		"err model option only for rovers": {
+			input: `5 5
+@model scout storage=2`,
+			expErr: fmt.Errorf("%w %s : %v", ErrInvalidDirective, "@model scout storage=2", fmt.Errorf("%w %s", ErrUnknownRoverOption, "storage")),
+		},
+		"err rover command unsupported by its model": {
+			input: `5 5
+@model rightie instructions=MR
+1 2 North model=rightie
+MMLM`,
+			expErr: &rover.ValidationError{Err: &rover.InvalidInstructionError{
+				Step:        2,
+				Instruction: rover.TurnLeft,
+				Err:         fmt.Errorf("%w %q : %s", rover.ErrUnsupportedInstruction, 'L', "rightie"),
+			}},
+		},
+	}
+
+	for description, test := range tests {
+		mission, err := ParseMission(test.input)
+		err = withoutRover(err)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected error %v but got %v", description, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		for i, r := range mission.Rovers {
+			assert.Equalf(t, test.expModels[i], r.Model, "%s failed, expected model %v but got %v", description, test.expModels[i], r.Model)
+		}
+	}
+
+	mission, err := ParseMission(`5 5
+@model scout battery=50 camera=3/90
+1 2 North model=scout
+M
+2 2 North model=scout battery=10
+M`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, &rover.Battery{Capacity: 50, Charge: 50}, mission.Rovers[0].Battery)
+		assert.Equal(t, &rover.Camera{Range: 3, Angle: 90}, mission.Rovers[0].Camera)
+		assert.Equal(t, &rover.Battery{Capacity: 10, Charge: 10}, mission.Rovers[1].Battery)
+		assert.NotSame(t, mission.Rovers[0].Camera, mission.Rovers[1].Camera)
+	}
+
+	//a rover overriding its model's terrain must not change the terrain of other rovers of the model
+	mission, err = ParseMission(`5 5
+@model scout terrain=sand:2
+1 2 North model=scout terrain=ice:0:impassable
+M
+2 2 North model=scout
+M`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, map[rover.Terrain]rover.Traversal{rover.Sand: {Cost: 2}, rover.Ice: {Impassable: true}}, mission.Rovers[0].Traversal)
+		assert.Equal(t, map[rover.Terrain]rover.Traversal{rover.Sand: {Cost: 2}}, mission.Rovers[1].Traversal)
+		assert.Equal(t, map[rover.Terrain]rover.Traversal{rover.Sand: {Cost: 2}}, mission.Rovers[1].Model.Traversal)
+	}
+}
@@ -0,0 +1,95 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrBatteryDepleted = errors.New("rover battery cannot afford the next instruction")
+	ErrInvalidBattery  = errors.New("rover battery charge must be between zero and its capacity")
+	ErrInvalidCost     = errors.New("instruction costs must not be negative")
+)
+
+//DefaultCosts is the energy each Instruction uses when a Battery does not set its own Costs.
+var DefaultCosts = map[Instruction]float64{
+	Move:       1,
+	TurnLeft:   0.5,
+	TurnRight:  0.5,
+	Wait:       0,
+	TakeSample: 1,
+	Photograph: 0.5,
+}
+
+//Battery stores the energy a Rover uses to execute Instructions. Costs sets the energy used by each Instruction,
+//Instructions missing from Costs use DefaultCosts, as does a Battery without Costs. ClimbCost is the extra energy used
+//by a Move for each unit of elevation it climbs, descending costs nothing extra.
+type Battery struct {
+	Capacity  float64
+	Charge    float64
+	Costs     map[Instruction]float64
+	ClimbCost float64
+}
+
+//Valid will return an error if the Battery's charge is outside its capacity, or any cost is negative.
+func (b *Battery) Valid() error {
+	if b == nil {
+		return nil
+	}
+
+	if b.Charge < 0 || b.Charge > b.Capacity {
+		return fmt.Errorf("%w : %g of %g", ErrInvalidBattery, b.Charge, b.Capacity)
+	}
+
+	if b.ClimbCost < 0 {
+		return fmt.Errorf("%w : climbing costs %g", ErrInvalidCost, b.ClimbCost)
+	}
+
+	for instruction, cost := range b.Costs {
+		if cost < 0 {
+			return fmt.Errorf("%w : %q costs %g", ErrInvalidCost, rune(instruction), cost)
+		}
+	}
+
+	return nil
+}
+
+//Cost returns the energy the Instruction uses.
+func (b *Battery) Cost(i Instruction) float64 {
+	if cost, ok := b.Costs[i]; ok {
+		return cost
+	}
+
+	return DefaultCosts[i]
+}
+
+//remaining returns the charge left in the Battery, a nil Battery has no charge.
+func (b *Battery) remaining() float64 {
+	if b == nil {
+		return 0
+	}
+
+	return b.Charge
+}
+
+//cost returns the energy the Instruction uses along with the given surcharge.
+func (b *Battery) cost(i Instruction, surcharge float64) float64 {
+	return b.Cost(i) + surcharge
+}
+
+//afford returns ErrBatteryDepleted if the Battery does not hold enough charge for the Instruction and the given
+//surcharge, a nil Battery can afford anything.
+func (b *Battery) afford(i Instruction, surcharge float64) error {
+	if b == nil || b.Charge >= b.cost(i, surcharge) {
+		return nil
+	}
+
+	return fmt.Errorf("%w : %q costs %g with %g remaining", ErrBatteryDepleted, rune(i), b.cost(i, surcharge), b.Charge)
+}
+
+//draw takes the cost of the Instruction and the given surcharge from the Battery.
+func (b *Battery) draw(i Instruction, surcharge float64) {
+	if b != nil {
+		b.Charge -= b.cost(i, surcharge)
+	}
+}
@@ -0,0 +1,106 @@
+package rover
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRover_ExploreBattery(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		expErr      error
+		expPosition Position
+		expCharge   float64
+	}{
+		"default costs drawn for each instruction": {
+			rover: &Rover{
+				Commands: "MRMW2",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Battery:  &Battery{Capacity: 10, Charge: 10},
+			},
+			expPosition: Position{Coordinate{1, 1}, East},
+			expCharge:   7.5,
+		},
+		"configured costs override defaults": {
+			rover: &Rover{
+				Commands: "MRW",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Battery:  &Battery{Capacity: 10, Charge: 10, Costs: map[Instruction]float64{Move: 3, Wait: 0.25}},
+			},
+			expPosition: Position{Coordinate{0, 1}, East},
+			expCharge:   6.25,
+		},
+		"err battery depleted before the next instruction": {
+			rover: &Rover{
+				Commands: "MMM",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Battery:  &Battery{Capacity: 10, Charge: 1.5},
+			},
+			expErr: &MovementError{
+				Step:        1,
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 1}, North},
+				Attempted:   Coordinate{0, 2},
+				Err:         fmt.Errorf("%w : %q costs %g with %g remaining", ErrBatteryDepleted, 'M', 1.0, 0.5),
+			},
+			expPosition: Position{Coordinate{0, 1}, North},
+			expCharge:   0.5,
+		},
+		"failed move does not draw charge": {
+			rover: &Rover{
+				Commands: "M",
+				Position: &Position{Coordinate{0, 2}, North},
+				Boundary: &Coordinate{2, 2},
+				Battery:  &Battery{Capacity: 10, Charge: 5},
+			},
+			expErr: &MovementError{
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 2}, North},
+				Attempted:   Coordinate{0, 3},
+				Err:         &OffPlateauError{Coordinate: Coordinate{0, 3}, Err: ErrBoundaryNorth},
+			},
+			expPosition: Position{Coordinate{0, 2}, North},
+			expCharge:   5,
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Explore()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expPosition, *test.rover.Position, "%s failed, expected position %v but got %v", desc, test.expPosition, *test.rover.Position)
+		assert.Equalf(t, test.expCharge, test.rover.Battery.Charge, "%s failed, expected charge %g but got %g", desc, test.expCharge, test.rover.Battery.Charge)
+	}
+}
+
+func TestBattery_Valid(t *testing.T) {
+	tests := map[string]struct {
+		battery *Battery
+		expErr  error
+	}{
+		"no battery is valid": {
+			battery: nil,
+		},
+		"full battery is valid": {
+			battery: &Battery{Capacity: 10, Charge: 10},
+		},
+		"err charge above capacity": {
+			battery: &Battery{Capacity: 10, Charge: 11},
+			expErr:  fmt.Errorf("%w : %g of %g", ErrInvalidBattery, 11.0, 10.0),
+		},
+		"err negative cost": {
+			battery: &Battery{Capacity: 10, Charge: 10, Costs: map[Instruction]float64{Move: -1}},
+			expErr:  fmt.Errorf("%w : %q costs %g", ErrInvalidCost, 'M', -1.0),
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.battery.Valid()
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+	}
+}
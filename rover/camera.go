@@ -0,0 +1,142 @@
+package rover
+
+import "math"
+
+//Camera describes what a Rover can see from its Position. Range is how many cells away the Camera can see, and Angle
+//is the width in degrees of the cone it sees, centred on the Direction the Rover is facing. Obstacles block the view
+//of anything behind them, but are themselves seen.
+type Camera struct {
+	Range int
+	Angle float64
+}
+
+//occlusionSamples is how many points per cell are checked along a line of sight for obstacles.
+const occlusionSamples = 4
+
+//Observe returns every Coordinate the Rover's Camera can see from its current Position, a Rover without a Camera sees
+//nothing.
+func (r *Rover) Observe() []Coordinate {
+	return r.view(*r.Position)
+}
+
+//view returns every Coordinate the Rover's Camera would see from the given Position.
+func (r *Rover) view(from Position) []Coordinate {
+	if r.Camera == nil {
+		return nil
+	}
+
+	ahead, err := from.ahead()
+	if err != nil {
+		return nil
+	}
+	fromX, fromY := r.Grid.point(from.Coordinate)
+	aheadX, aheadY := r.Grid.point(ahead)
+	headingX, headingY := aheadX-fromX, aheadY-fromY
+	halfAngle := math.Cos(r.Camera.Angle / 2 * math.Pi / 180)
+
+	//the rows and columns of a Hex Grid are skewed, so cells within range may lie up to twice the range away in either
+	span := r.Camera.Range
+	if r.Grid == Hex {
+		span *= 2
+	}
+
+	var seen []Coordinate
+	for y := from.Y - span; y <= from.Y+span; y++ {
+		for x := from.X - span; x <= from.X+span; x++ {
+			c := Coordinate{X: x, Y: y}
+			if !r.contains(c) {
+				continue
+			}
+
+			cx, cy := r.Grid.point(c)
+			dx, dy := cx-fromX, cy-fromY
+			distance := math.Hypot(dx, dy)
+			switch {
+			case distance == 0:
+			case distance > float64(r.Camera.Range):
+				continue
+			case (dx*headingX+dy*headingY)/distance < halfAngle-1e-9:
+				continue
+			case r.occluded(from.Coordinate, c):
+				continue
+			}
+
+			seen = append(seen, c)
+		}
+	}
+
+	return seen
+}
+
+//occluded reports whether an obstacle lies on the line of sight between two Coordinates, not counting either end.
+func (r *Rover) occluded(from, to Coordinate) bool {
+	if r.Plateau == nil || len(r.Plateau.Obstacles) == 0 {
+		return false
+	}
+
+	dx, dy := float64(to.X-from.X), float64(to.Y-from.Y)
+	samples := int(math.Ceil(math.Hypot(dx, dy))) * occlusionSamples
+	for i := 1; i < samples; i++ {
+		t := float64(i) / float64(samples)
+		c := Coordinate{
+			X: from.X + int(math.Round(dx*t)),
+			Y: from.Y + int(math.Round(dy*t)),
+		}
+
+		if c != from && c != to && r.Plateau.Blocked(c) {
+			return true
+		}
+	}
+
+	return false
+}
+
+//CameraCoverage reports how much of the plateau the Rovers of a Mission have seen with their Cameras.
+type CameraCoverage struct {
+	//Rovers holds the fraction of the plateau each Rover has seen, between 0 and 1, in the same order as the Rovers.
+	Rovers []float64
+	//Squad is the fraction of the plateau seen by at least one Rover, between 0 and 1.
+	Squad float64
+	//Unseen holds every Coordinate of the plateau no Rover has seen.
+	Unseen []Coordinate
+}
+
+//CameraCoverage reports how much of the plateau of the first Rover has been seen, according to the observations
+//recorded in the Trace. Obstacles are not counted as part of the plateau.
+func (m *Mission) CameraCoverage(t *Trace) *CameraCoverage {
+	coverage := &CameraCoverage{
+		Rovers: make([]float64, len(m.Rovers)),
+	}
+	if len(m.Rovers) == 0 {
+		return coverage
+	}
+
+	cells := m.Rovers[0].cells()
+	if len(cells) == 0 {
+		return coverage
+	}
+
+	seen := 0
+	for _, c := range cells {
+		squad := false
+		for i := range m.Rovers {
+			if i < len(t.Observed) && t.Observed[i][c] {
+				coverage.Rovers[i]++
+				squad = true
+			}
+		}
+
+		if squad {
+			seen++
+		} else {
+			coverage.Unseen = append(coverage.Unseen, c)
+		}
+	}
+
+	for i := range coverage.Rovers {
+		coverage.Rovers[i] /= float64(len(cells))
+	}
+	coverage.Squad = float64(seen) / float64(len(cells))
+
+	return coverage
+}
@@ -0,0 +1,76 @@
+package rover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRover_Observe(t *testing.T) {
+	tests := map[string]struct {
+		rover   *Rover
+		expSeen []Coordinate
+	}{
+		"rover without a camera sees nothing": {
+			rover:   &Rover{Position: &Position{Coordinate{1, 1}, North}, Boundary: &Coordinate{2, 2}},
+			expSeen: nil,
+		},
+		"narrow camera sees straight ahead within range": {
+			rover: &Rover{
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{3, 3},
+				Camera:   &Camera{Range: 2, Angle: 0},
+			},
+			expSeen: []Coordinate{{0, 0}, {0, 1}, {0, 2}},
+		},
+		"right angle cone sees the diagonals ahead": {
+			rover: &Rover{
+				Position: &Position{Coordinate{1, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Camera:   &Camera{Range: 1, Angle: 90},
+			},
+			expSeen: []Coordinate{{1, 0}, {1, 1}},
+		},
+		"wide cone is clipped by the boundary": {
+			rover: &Rover{
+				Position: &Position{Coordinate{2, 1}, East},
+				Boundary: &Coordinate{2, 2},
+				Camera:   &Camera{Range: 2, Angle: 180},
+			},
+			expSeen: []Coordinate{{2, 0}, {2, 1}, {2, 2}},
+		},
+		"obstacles block the view behind them": {
+			rover: &Rover{
+				Position: &Position{Coordinate{0, 0}, East},
+				Boundary: &Coordinate{4, 0},
+				Plateau:  &Plateau{Obstacles: map[Coordinate]bool{{2, 0}: true}},
+				Camera:   &Camera{Range: 4, Angle: 90},
+			},
+			expSeen: []Coordinate{{0, 0}, {1, 0}, {2, 0}},
+		},
+	}
+
+	for desc, test := range tests {
+		seen := test.rover.Observe()
+		assert.Equalf(t, test.expSeen, seen, "%s failed, expected to see %v but saw %v", desc, test.expSeen, seen)
+	}
+}
+
+func TestMission_CameraCoverage(t *testing.T) {
+	mission := &Mission{
+		Mode: Lockstep,
+		Rovers: Rovers{
+			{Commands: "MM", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}, Camera: &Camera{Range: 1, Angle: 0}},
+			{Commands: "R", Position: &Position{Coordinate{2, 2}, West}, Boundary: &Coordinate{2, 2}, Camera: &Camera{Range: 1, Angle: 0}},
+		},
+	}
+
+	trace, err := mission.Run()
+	assert.Nil(t, err)
+
+	coverage := mission.CameraCoverage(trace)
+	expUnseen := []Coordinate{{1, 0}, {2, 0}, {1, 1}, {2, 1}}
+	assert.InDeltaSlicef(t, []float64{3.0 / 9.0, 2.0 / 9.0}, coverage.Rovers, 0.0001, "expected rover coverage but got %v", coverage.Rovers)
+	assert.InDeltaf(t, 5.0/9.0, coverage.Squad, 0.0001, "expected squad coverage but got %v", coverage.Squad)
+	assert.Equalf(t, expUnseen, coverage.Unseen, "expected unseen %v but got %v", expUnseen, coverage.Unseen)
+}
@@ -0,0 +1,105 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrInvalidClock = errors.New("clock sol length must be positive and rates must not be negative")
+
+//Insolation returns how much sunlight reaches the plateau, between 0 and 1, at a phase of the sol between 0 and 1.
+type Insolation func(phase float64) float64
+
+//DefaultInsolation is daylight for the first half of each sol, peaking at a quarter of the way through, and darkness
+//for the second half.
+func DefaultInsolation(phase float64) float64 {
+	return math.Max(0, math.Sin(2*math.Pi*phase))
+}
+
+//Clock keeps the time of a Mission, ticking once for each instruction, and applies the day and night cycle to every
+//Rover's Battery at the end of each tick. In sunlight a Battery gains up to ChargeRate each tick, in darkness it loses
+//up to NightDrain each tick to keep the Rover warm, and in between it gains and loses in proportion to the sunlight.
+type Clock struct {
+	//SolLength is the number of ticks in a sol.
+	SolLength int
+	//Start is the tick of the sol the Mission starts on.
+	Start int
+	//Insolation gives the sunlight through the sol, DefaultInsolation is used when it is nil.
+	Insolation Insolation
+	ChargeRate float64
+	NightDrain float64
+}
+
+//Valid will return an error if the Clock has no sol length or has negative rates.
+func (c *Clock) Valid() error {
+	if c == nil {
+		return nil
+	}
+
+	if c.SolLength < 1 || c.Start < 0 || c.ChargeRate < 0 || c.NightDrain < 0 {
+		return fmt.Errorf("%w : sol length %d, start %d, charge rate %g, night drain %g", ErrInvalidClock, c.SolLength,
+			c.Start, c.ChargeRate, c.NightDrain)
+	}
+
+	return nil
+}
+
+//Time returns the sol and the phase of the sol, between 0 and 1, during the given tick of the Mission, which starts
+//on tick 1.
+func (c *Clock) Time(tick int) (int, float64) {
+	elapsed := c.Start + tick - 1
+	return elapsed / c.SolLength, float64(elapsed%c.SolLength) / float64(c.SolLength)
+}
+
+//Sunlight returns how much sunlight reaches the plateau during the given tick, between 0 and 1.
+func (c *Clock) Sunlight(tick int) float64 {
+	insolation := c.Insolation
+	if insolation == nil {
+		insolation = DefaultInsolation
+	}
+
+	_, phase := c.Time(tick)
+
+	return math.Min(1, math.Max(0, insolation(phase)))
+}
+
+//UntilDaylight returns how many ticks from the given tick until the sunlight reaches the threshold, so that planners
+//can wait for daylight before a long drive. If the threshold is never reached within a sol, -1 is returned.
+func (c *Clock) UntilDaylight(tick int, threshold float64) int {
+	for wait := 0; wait < c.SolLength; wait++ {
+		if c.Sunlight(tick+wait) >= threshold {
+			return wait
+		}
+	}
+
+	return -1
+}
+
+//stamp records the time of the tick on it, a nil Clock records nothing.
+func (c *Clock) stamp(tick *Tick) {
+	if c == nil {
+		return
+	}
+
+	tick.Sol, tick.Phase = c.Time(tick.Number)
+	tick.Sunlight = c.Sunlight(tick.Number)
+}
+
+//charge applies the sunlight, or darkness, of the tick to the Battery of every Rover, keeping each Battery's charge
+//between zero and its capacity.
+func (c *Clock) charge(rovers Rovers, tick int) {
+	if c == nil {
+		return
+	}
+
+	sunlight := c.Sunlight(tick)
+	for _, r := range rovers {
+		if r.Battery == nil {
+			continue
+		}
+
+		charge := r.Battery.Charge + c.ChargeRate*sunlight - c.NightDrain*(1-sunlight)
+		r.Battery.Charge = math.Min(r.Battery.Capacity, math.Max(0, charge))
+	}
+}
@@ -0,0 +1,126 @@
+package rover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//dayThenNight is daylight for the first half of each sol and darkness for the second, without the rounding of
+//DefaultInsolation.
+func dayThenNight(phase float64) float64 {
+	if phase < 0.5 {
+		return 1
+	}
+
+	return 0
+}
+
+func TestClock_UntilDaylight(t *testing.T) {
+	tests := map[string]struct {
+		clock     *Clock
+		tick      int
+		threshold float64
+		expWait   int
+	}{
+		"already daylight": {
+			clock:     &Clock{SolLength: 4},
+			tick:      2,
+			threshold: 0.5,
+			expWait:   0,
+		},
+		"waits through the night until the sun is high enough": {
+			clock:     &Clock{SolLength: 4},
+			tick:      3,
+			threshold: 0.5,
+			expWait:   3,
+		},
+		"start offsets the time of day": {
+			clock:     &Clock{SolLength: 4, Start: 1},
+			tick:      1,
+			threshold: 0.5,
+			expWait:   0,
+		},
+		"custom insolation curve": {
+			clock:     &Clock{SolLength: 4, Insolation: dayThenNight},
+			tick:      3,
+			threshold: 1,
+			expWait:   2,
+		},
+		"threshold never reached": {
+			clock:     &Clock{SolLength: 4},
+			tick:      1,
+			threshold: 2,
+			expWait:   -1,
+		},
+	}
+
+	for desc, test := range tests {
+		wait := test.clock.UntilDaylight(test.tick, test.threshold)
+		assert.Equalf(t, test.expWait, wait, "%s failed, expected wait %d but got %d", desc, test.expWait, wait)
+	}
+}
+
+func TestMission_RunClock(t *testing.T) {
+	tests := map[string]struct {
+		mission     *Mission
+		expSunlight []float64
+		expSols     []int
+		expCharges  [][]float64
+		expBattery  []float64
+	}{
+		"sequential rover charges by day and drains by night": {
+			mission: &Mission{
+				Mode:  Sequential,
+				Clock: &Clock{SolLength: 2, Insolation: dayThenNight, ChargeRate: 2, NightDrain: 0.5},
+				Rovers: Rovers{
+					{Commands: "MMMM", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{5, 5},
+						Battery: &Battery{Capacity: 10, Charge: 5}},
+				},
+			},
+			expSunlight: []float64{1, 0, 1, 0},
+			expSols:     []int{0, 0, 1, 1},
+			expCharges:  [][]float64{{6}, {4.5}, {5.5}, {4}},
+			expBattery:  []float64{4},
+		},
+		"lockstep rovers charge every tick up to capacity, even once finished": {
+			mission: &Mission{
+				Mode:  Lockstep,
+				Clock: &Clock{SolLength: 2, Insolation: dayThenNight, ChargeRate: 2, NightDrain: 0.5},
+				Rovers: Rovers{
+					{Commands: "MM", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+						Battery: &Battery{Capacity: 10, Charge: 9}},
+					{Commands: "M", Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 2},
+						Battery: &Battery{Capacity: 4, Charge: 4}},
+				},
+			},
+			expSunlight: []float64{1, 0},
+			expSols:     []int{0, 0},
+			expCharges:  [][]float64{{10, 4}, {8.5}},
+			expBattery:  []float64{8.5, 3.5},
+		},
+	}
+
+	for desc, test := range tests {
+		trace, err := test.mission.Run()
+		assert.NoErrorf(t, err, "%s failed, expected no error but got %v", desc, err)
+		if !assert.Lenf(t, trace.Ticks, len(test.expSunlight), "%s failed, unexpected number of ticks", desc) {
+			continue
+		}
+
+		for i, tick := range trace.Ticks {
+			assert.Equalf(t, test.expSunlight[i], tick.Sunlight, "%s failed, expected sunlight %g on tick %d but got %g", desc, test.expSunlight[i], tick.Number, tick.Sunlight)
+			assert.Equalf(t, test.expSols[i], tick.Sol, "%s failed, expected sol %d on tick %d but got %d", desc, test.expSols[i], tick.Number, tick.Sol)
+
+			charges := make([]float64, 0, len(tick.Steps))
+			for _, step := range tick.Steps {
+				charges = append(charges, step.Charge)
+			}
+			assert.Equalf(t, test.expCharges[i], charges, "%s failed, expected charges %v on tick %d but got %v", desc, test.expCharges[i], tick.Number, charges)
+		}
+
+		for i, r := range test.mission.Rovers {
+			assert.Equalf(t, test.expBattery[i], r.Battery.Charge, "%s failed, expected rover %d charge %g but got %g", desc, i, test.expBattery[i], r.Battery.Charge)
+		}
+	}
+}
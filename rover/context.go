@@ -0,0 +1,75 @@
+package rover
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+//CancelledError is returned when a Rover stops executing its commands because its context was cancelled or timed out.
+//It records where the Rover was when it stopped, and the index of the first command it did not execute. Rover is the
+//index of the Rover within its Mission, or -1 for a Rover exploring on its own.
+type CancelledError struct {
+	Rover    int
+	Name     string
+	Position Position
+	Step     int
+	Err      error
+}
+
+func (e *CancelledError) Error() string {
+	rover := "rover"
+	switch {
+	case e.Name != "":
+		rover += " " + e.Name
+	case e.Rover >= 0:
+		rover += " " + strconv.Itoa(e.Rover)
+	}
+
+	return fmt.Sprintf("%s stopped at (%d, %d) before command %d : %v", rover, e.Position.X, e.Position.Y, e.Step, e.Err)
+}
+
+//Unwrap returns the context error which caused the cancellation, so errors.Is(err, context.Canceled) works.
+func (e *CancelledError) Unwrap() error {
+	return e.Err
+}
+
+//ExploreContext behaves like Explore but checks ctx before every instruction, returning a *CancelledError holding the
+//Rover's Position if ctx is cancelled before all of the instructions have been executed. Each instruction takes a
+//tick counted on from the Plateau's current Tick, which the Plateau is put back on once the Rover stops.
+func (r *Rover) ExploreContext(ctx context.Context) error {
+	start := r.Plateau.now()
+	defer r.Plateau.advance(start)
+
+	instructions, invalid := r.decode(r.Commands)
+	for step, instruction := range instructions {
+		if err := r.cancelled(ctx, -1, step); err != nil {
+			return err
+		}
+
+		r.Plateau.advance(start + step + 1)
+
+		if err := r.execute(step, instruction); err != nil {
+			return err
+		}
+	}
+
+	//instructions before an invalid one are still executed, matching a Rover reading its commands one at a time
+	return invalid
+}
+
+//cancelled returns a *CancelledError if ctx has been cancelled, index is the Rover's index within its Mission or -1
+//outside of one.
+func (r *Rover) cancelled(ctx context.Context, index, step int) error {
+	if err := ctx.Err(); err != nil {
+		return &CancelledError{
+			Rover:    index,
+			Name:     r.Name,
+			Position: *r.Position,
+			Step:     step,
+			Err:      err,
+		}
+	}
+
+	return nil
+}
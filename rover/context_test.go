@@ -0,0 +1,137 @@
+package rover
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//countdownContext is cancelled once its Err method has been called more than checks times.
+type countdownContext struct {
+	context.Context
+	checks int
+}
+
+func (c *countdownContext) Err() error {
+	if c.checks == 0 {
+		return context.Canceled
+	}
+	c.checks--
+
+	return nil
+}
+
+func TestRover_ExploreContext(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		ctx         context.Context
+		expErr      error
+		expPosition Position
+	}{
+		"explores every command when not cancelled": {
+			rover:       &Rover{Commands: "MMR", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			ctx:         context.Background(),
+			expPosition: Position{Coordinate{0, 2}, East},
+		},
+		"err cancelled part way through commands": {
+			rover: &Rover{Commands: "MMR", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			ctx:   &countdownContext{Context: context.Background(), checks: 1},
+			expErr: &CancelledError{
+				Rover:    -1,
+				Position: Position{Coordinate{0, 1}, North},
+				Step:     1,
+				Err:      context.Canceled,
+			},
+			expPosition: Position{Coordinate{0, 1}, North},
+		},
+		"err cancelled before any commands": {
+			rover: &Rover{Commands: "MMR", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			ctx:   &countdownContext{Context: context.Background()},
+			expErr: &CancelledError{
+				Rover:    -1,
+				Position: Position{Coordinate{0, 0}, North},
+				Err:      context.Canceled,
+			},
+			expPosition: Position{Coordinate{0, 0}, North},
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.ExploreContext(test.ctx)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expPosition, *test.rover.Position, "%s failed, expected position %v but got %v", desc, test.expPosition, *test.rover.Position)
+	}
+}
+
+func TestMission_RunContext(t *testing.T) {
+	tests := map[string]struct {
+		mission *Mission
+		ctx     context.Context
+		expErr  error
+	}{
+		"err sequential mission cancelled on the second rover": {
+			mission: &Mission{
+				Mode: Sequential,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+					{Commands: "MM", Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			ctx: &countdownContext{Context: context.Background(), checks: 2},
+			expErr: &CancelledError{
+				Rover:    1,
+				Position: Position{Coordinate{1, 1}, North},
+				Step:     1,
+				Err:      context.Canceled,
+			},
+		},
+		"err lockstep mission cancelled reports first rover still executing": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+					{Commands: "MM", Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			ctx: &countdownContext{Context: context.Background(), checks: 2},
+			expErr: &CancelledError{
+				Rover:    1,
+				Position: Position{Coordinate{1, 1}, North},
+				Step:     1,
+				Err:      context.Canceled,
+			},
+		},
+	}
+
+	for desc, test := range tests {
+		_, err := test.mission.RunContext(test.ctx)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Truef(t, errors.Is(err, context.Canceled), "%s failed, expected error to wrap context.Canceled", desc)
+	}
+}
+
+func TestCancelledError_Error(t *testing.T) {
+	tests := map[string]struct {
+		err *CancelledError
+		exp string
+	}{
+		"rover exploring on its own": {
+			err: &CancelledError{Rover: -1, Position: Position{Coordinate{0, 1}, North}, Step: 1, Err: context.Canceled},
+			exp: "rover stopped at (0, 1) before command 1 : context canceled",
+		},
+		"rover of a mission": {
+			err: &CancelledError{Rover: 0, Position: Position{Coordinate{0, 1}, North}, Step: 1, Err: context.Canceled},
+			exp: "rover 0 stopped at (0, 1) before command 1 : context canceled",
+		},
+		"named rover": {
+			err: &CancelledError{Rover: -1, Name: "Spirit", Position: Position{Coordinate{0, 1}, North}, Step: 1, Err: context.Canceled},
+			exp: "rover Spirit stopped at (0, 1) before command 1 : context canceled",
+		},
+	}
+
+	for desc, test := range tests {
+		assert.Equalf(t, test.exp, test.err.Error(), "%s failed", desc)
+	}
+}
@@ -0,0 +1,190 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrNoRovers = errors.New("at least one rover is required")
+
+//CoveragePlan holds the commands for each Rover of a squad which together visit the plateau, along with how much of
+//the plateau they cover.
+type CoveragePlan struct {
+	//Commands holds the commands for each Rover, in the same order as the Rovers, a Rover with nothing to visit is
+	//given no commands.
+	Commands []string
+	//Regions holds the Coordinates each Rover was assigned to visit.
+	Regions [][]Coordinate
+	//Visited holds every Coordinate which the plan visits.
+	Visited map[Coordinate]bool
+	//Unvisited holds every Coordinate of the plateau which the plan does not visit.
+	Unvisited []Coordinate
+	//Cells is the number of Coordinates on the plateau which are not obstacles.
+	Cells int
+	//Instructions is the total number of instructions across every Rover.
+	Instructions int
+}
+
+//Coverage returns the fraction of the plateau's Cells which the plan visits, between 0 and 1.
+func (p *CoveragePlan) Coverage() float64 {
+	if p.Cells == 0 {
+		return 0
+	}
+
+	return float64(len(p.Visited)) / float64(p.Cells)
+}
+
+//PlanCoverage splits the plateau of the first Rover between the Rovers, giving each Coordinate to the Rover which can
+//reach it in the fewest moves, then plans commands for each Rover to visit every Coordinate of its region. Plans
+//assume the Rovers run one after another, so each plan avoids where the other Rovers will be while it executes.
+//Coordinates which no Rover can reach are left unvisited rather than returning an error. The Rovers are not moved.
+func PlanCoverage(rovers Rovers) (*CoveragePlan, error) {
+	if len(rovers) == 0 {
+		return nil, ErrNoRovers
+	}
+
+	positions := make([]Position, len(rovers))
+	for i, r := range rovers {
+		if err := r.validPlacement(); err != nil {
+			return nil, err
+		}
+		positions[i] = r.Believed()
+	}
+
+	plan := &CoveragePlan{
+		Commands: make([]string, len(rovers)),
+		Regions:  rovers.regions(),
+		Visited:  make(map[Coordinate]bool),
+		Cells:    len(rovers[0].cells()),
+	}
+	for _, p := range positions {
+		plan.Visited[p.Coordinate] = true
+	}
+
+	for i, r := range rovers {
+		occupied := make(map[Coordinate]bool, len(rovers))
+		for j, p := range positions {
+			if j != i {
+				occupied[p.Coordinate] = true
+			}
+		}
+
+		region := make(map[Coordinate]bool, len(plan.Regions[i]))
+		for _, c := range plan.Regions[i] {
+			region[c] = true
+		}
+
+		unvisited := func(p Position) bool {
+			return region[p.Coordinate] && !plan.Visited[p.Coordinate]
+		}
+
+		for {
+			commands, end, found := r.search(positions[i], occupied, unvisited)
+			if !found {
+				break
+			}
+
+			visits, err := r.trail(positions[i], commands)
+			if err != nil {
+				return nil, fmt.Errorf("coverage planned invalid commands : %w", err)
+			}
+			for _, c := range visits {
+				plan.Visited[c] = true
+			}
+
+			plan.Commands[i] += commands
+			positions[i] = end
+		}
+
+		plan.Instructions += len(plan.Commands[i])
+	}
+
+	for _, c := range rovers[0].cells() {
+		if !plan.Visited[c] {
+			plan.Unvisited = append(plan.Unvisited, c)
+		}
+	}
+
+	return plan, nil
+}
+
+//regions assigns every Coordinate on the plateau of the first Rover to the Rover which can reach it in the fewest
+//moves, ties go to the Rover deployed first. Coordinates no Rover can reach are not assigned.
+func (rs Rovers) regions() [][]Coordinate {
+	regions := make([][]Coordinate, len(rs))
+	owners := make(map[Coordinate]int, len(rs))
+
+	var frontier []Coordinate
+	for i, r := range rs {
+		start := r.Believed().Coordinate
+		if _, owned := owners[start]; !owned {
+			owners[start] = i
+			regions[i] = append(regions[i], start)
+			frontier = append(frontier, start)
+		}
+	}
+
+	for ; len(frontier) > 0; frontier = frontier[1:] {
+		current := frontier[0]
+		owner := owners[current]
+		for _, next := range rs[owner].neighbours(current) {
+			if _, owned := owners[next]; !owned {
+				owners[next] = owner
+				regions[owner] = append(regions[owner], next)
+				frontier = append(frontier, next)
+			}
+		}
+	}
+
+	return regions
+}
+
+//cells returns every Coordinate on the Rover's plateau which is within its boundary and is not an obstacle.
+func (r *Rover) cells() []Coordinate {
+	var cells []Coordinate
+	for y := r.Origin.Y; y <= r.Boundary.Y; y++ {
+		for x := r.Origin.X; x <= r.Boundary.X; x++ {
+			c := Coordinate{X: x, Y: y}
+			if r.contains(c) && !r.Plateau.Blocked(c) {
+				cells = append(cells, c)
+			}
+		}
+	}
+
+	return cells
+}
+
+//neighbours returns the Coordinates the Rover could move to in a single move from the given Coordinate.
+func (r *Rover) neighbours(c Coordinate) []Coordinate {
+	var neighbours []Coordinate
+	for _, d := range r.Grid.Headings() {
+		if next, err := r.after(Position{Coordinate: c, Direction: d}, Move); err == nil {
+			neighbours = append(neighbours, next.Coordinate)
+		}
+	}
+
+	return neighbours
+}
+
+//trail returns every Coordinate the Rover passes through executing the commands from the given Position.
+func (r *Rover) trail(from Position, commands string) ([]Coordinate, error) {
+	instructions, err := r.decode(commands)
+	if err != nil {
+		return nil, err
+	}
+
+	trail := []Coordinate{from.Coordinate}
+	for _, instruction := range instructions {
+		next, err := r.after(from, instruction)
+		if err != nil {
+			return nil, err
+		}
+
+		if next.Coordinate != from.Coordinate {
+			trail = append(trail, next.Coordinate)
+		}
+		from = next
+	}
+
+	return trail, nil
+}
@@ -0,0 +1,87 @@
+package rover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanCoverage(t *testing.T) {
+	tests := map[string]struct {
+		rovers       Rovers
+		expCoverage  float64
+		expUnvisited []Coordinate
+		expErr       error
+	}{
+		"single rover covers the whole plateau": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{3, 3}},
+			},
+			expCoverage: 1,
+		},
+		"squad splits the plateau and covers it": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{5, 5}},
+				{Position: &Position{Coordinate{5, 5}, South}, Boundary: &Coordinate{5, 5}},
+				{Position: &Position{Coordinate{0, 5}, East}, Boundary: &Coordinate{5, 5}},
+			},
+			expCoverage: 1,
+		},
+		"squad covers around obstacles": {
+			rovers: Rovers{
+				{
+					Position: &Position{Coordinate{0, 0}, North},
+					Boundary: &Coordinate{4, 4},
+					Plateau:  &Plateau{Obstacles: map[Coordinate]bool{{2, 0}: true, {2, 1}: true, {2, 2}: true, {2, 3}: true}},
+				},
+				{
+					Position: &Position{Coordinate{4, 0}, North},
+					Boundary: &Coordinate{4, 4},
+					Plateau:  &Plateau{Obstacles: map[Coordinate]bool{{2, 0}: true, {2, 1}: true, {2, 2}: true, {2, 3}: true}},
+				},
+			},
+			expCoverage: 1,
+		},
+		"walled off coordinates are reported unvisited": {
+			rovers: Rovers{
+				{
+					Position: &Position{Coordinate{0, 0}, North},
+					Boundary: &Coordinate{2, 2},
+					Plateau:  &Plateau{Obstacles: map[Coordinate]bool{{1, 2}: true, {2, 1}: true}},
+				},
+			},
+			expCoverage:  6.0 / 7.0,
+			expUnvisited: []Coordinate{{2, 2}},
+		},
+		"err no rovers": {
+			rovers: Rovers{},
+			expErr: ErrNoRovers,
+		},
+	}
+
+	for desc, test := range tests {
+		plan, err := PlanCoverage(test.rovers)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		assert.InDeltaf(t, test.expCoverage, plan.Coverage(), 0.0001, "%s failed, expected coverage %f but got %f", desc, test.expCoverage, plan.Coverage())
+		assert.Equalf(t, test.expUnvisited, plan.Unvisited, "%s failed, expected unvisited %v but got %v", desc, test.expUnvisited, plan.Unvisited)
+
+		//running the plans one after another must visit every coordinate the plan claims to
+		visited := make(map[Coordinate]bool)
+		instructions := 0
+		for i, r := range test.rovers {
+			visited[r.Position.Coordinate] = true
+			trail, err := r.trail(*r.Position, plan.Commands[i])
+			assert.Nilf(t, err, "%s failed, expected rover %d commands to be valid but got %v", desc, i, err)
+			for _, c := range trail {
+				visited[c] = true
+			}
+			instructions += len(plan.Commands[i])
+		}
+		assert.Equalf(t, plan.Visited, visited, "%s failed, expected plans to visit %v but visited %v", desc, plan.Visited, visited)
+		assert.Equalf(t, plan.Instructions, instructions, "%s failed, expected %d instructions but got %d", desc, plan.Instructions, instructions)
+	}
+}
@@ -1,8 +1,14 @@
 package rover
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrUnknownDirection = errors.New("rover facing unknown direction")
 
-//Direction describes the way a Rover is facing, using the four cardinal compass points.
+//Direction describes the way a Rover is facing, using the four cardinal compass points on a Square Grid or the six
+//directions of hexCompass on a Hex Grid.
 type Direction uint8
 
 //go:generate stringer -type=Direction
@@ -12,13 +18,20 @@ const (
 	East
 	South
 	West
+	NorthEast
+	SouthEast
+	SouthWest
+	NorthWest
 )
 
+//compass holds the four cardinal Directions, clockwise from North.
+var compass = []Direction{North, East, South, West}
+
 func errUnknownDirection(d Direction) error {
-	return fmt.Errorf("rover facing unknown direction %v", d)
+	return fmt.Errorf("%w %v", ErrUnknownDirection, d)
 }
 
-//Valid will return an error if the direction is not one of the four cardinal directions.
+//Valid will return an error if the direction is not one of the four cardinal directions of a Square Grid.
 func (d Direction) Valid() error {
 	switch d {
 	case North:
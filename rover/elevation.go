@@ -0,0 +1,245 @@
+package rover
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/png" //registers PNG with image.Decode
+	"io"
+	"math"
+	"strconv"
+)
+
+var (
+	ErrSlopeTooSteep    = errors.New("slope ahead is too steep for the rover")
+	ErrInvalidSlope     = errors.New("rover slope limit must not be negative")
+	ErrInvalidElevation = errors.New("invalid elevation grid")
+)
+
+//SlopeError is returned, wrapped in a *MovementError, when a Rover tries to move between two Coordinates whose
+//difference in elevation is greater than the Rover's slope limit. It unwraps to ErrSlopeTooSteep.
+type SlopeError struct {
+	From  Coordinate
+	To    Coordinate
+	Slope float64
+	Limit float64
+}
+
+func (e *SlopeError) Error() string {
+	return fmt.Sprintf("%v from %v to %v : slope %g exceeds limit %g", ErrSlopeTooSteep, e.From, e.To, e.Slope, e.Limit)
+}
+
+func (e *SlopeError) Unwrap() error {
+	return ErrSlopeTooSteep
+}
+
+//SetElevation sets the height of the Coordinate, measured in cells so that a slope of 1 rises one cell per cell.
+func (p *Plateau) SetElevation(c Coordinate, height float64) {
+	if p.Elevation == nil {
+		p.Elevation = make(map[Coordinate]float64)
+	}
+	p.Elevation[c] = height
+}
+
+//Height returns the elevation of the Coordinate, anywhere without an elevation is at zero.
+func (p *Plateau) Height(c Coordinate) float64 {
+	if p == nil {
+		return 0
+	}
+
+	return p.Elevation[c]
+}
+
+//Slope returns the gradient of moving from one Coordinate to its neighbour, positive when climbing.
+func (p *Plateau) Slope(from, to Coordinate) float64 {
+	return p.Height(to) - p.Height(from)
+}
+
+//LoadElevationCSV sets the elevation of the Plateau from a grid of comma separated heights. Like an image, the first
+//row is the northern most, and the first column of the last row is at the given origin.
+func (p *Plateau) LoadElevationCSV(r io.Reader, origin Coordinate) error {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return fmt.Errorf("%w : %v", ErrInvalidElevation, err)
+	}
+
+	heights := make([][]float64, len(records))
+	for row, record := range records {
+		heights[row] = make([]float64, len(record))
+		for column, value := range record {
+			heights[row][column], err = strconv.ParseFloat(value, 64)
+			if err != nil {
+				return fmt.Errorf("%w : row %d column %d : %v", ErrInvalidElevation, row+1, column+1, err)
+			}
+		}
+	}
+
+	p.setElevations(origin, heights)
+
+	return nil
+}
+
+//LoadElevationImage sets the elevation of the Plateau from a grayscale PNG or PGM image, one pixel per Coordinate
+//with the top row of the image the northern most and the bottom left pixel at the given origin. Black is at zero and
+//white is at the given scale.
+func (p *Plateau) LoadElevationImage(r io.Reader, origin Coordinate, scale float64) error {
+	reader := bufio.NewReader(r)
+	magic, err := reader.Peek(2)
+	if err != nil {
+		return fmt.Errorf("%w : %v", ErrInvalidElevation, err)
+	}
+
+	var heights [][]float64
+	switch string(magic) {
+	case "P2", "P5":
+		heights, err = readPGM(reader)
+	default:
+		heights, err = readImage(reader)
+	}
+	if err != nil {
+		return fmt.Errorf("%w : %v", ErrInvalidElevation, err)
+	}
+
+	for _, row := range heights {
+		for column := range row {
+			row[column] *= scale
+		}
+	}
+	p.setElevations(origin, heights)
+
+	return nil
+}
+
+//setElevations sets the elevation of every Coordinate in the grid, whose first row is the northern most and whose
+//last row starts at the origin.
+func (p *Plateau) setElevations(origin Coordinate, heights [][]float64) {
+	for row, values := range heights {
+		for column, height := range values {
+			p.SetElevation(Coordinate{X: origin.X + column, Y: origin.Y + len(heights) - 1 - row}, height)
+		}
+	}
+}
+
+//readImage decodes an image registered with the image package, returning the brightness of every pixel between 0 and
+//1, row by row from the top.
+func readImage(r io.Reader) ([][]float64, error) {
+	img, _, err := image.Decode(r)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := img.Bounds()
+	heights := make([][]float64, bounds.Dy())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		row := make([]float64, bounds.Dx())
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			gray := color.Gray16Model.Convert(img.At(x, y)).(color.Gray16)
+			row[x-bounds.Min.X] = float64(gray.Y) / math.MaxUint16
+		}
+		heights[y-bounds.Min.Y] = row
+	}
+
+	return heights, nil
+}
+
+//readPGM reads a plain (P2) or raw (P5) portable graymap, returning the brightness of every pixel between 0 and 1, row
+//by row from the top.
+func readPGM(r *bufio.Reader) ([][]float64, error) {
+	magic, err := pgmToken(r)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]int, 3) //width, height, and maximum value
+	for i := range header {
+		token, err := pgmToken(r)
+		if err != nil {
+			return nil, err
+		}
+
+		header[i], err = strconv.Atoi(token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	width, height, maxValue := header[0], header[1], header[2]
+	if width < 1 || height < 1 || maxValue < 1 || maxValue > math.MaxUint16 {
+		return nil, fmt.Errorf("pgm header %dx%d with maximum %d", width, height, maxValue)
+	}
+
+	heights := make([][]float64, height)
+	for y := range heights {
+		heights[y] = make([]float64, width)
+		for x := range heights[y] {
+			var value int
+			if magic == "P2" {
+				token, err := pgmToken(r)
+				if err != nil {
+					return nil, err
+				}
+
+				if value, err = strconv.Atoi(token); err != nil {
+					return nil, err
+				}
+			} else {
+				value, err = pgmSample(r, maxValue)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if value < 0 || value > maxValue {
+				return nil, fmt.Errorf("pgm value %d exceeds maximum %d", value, maxValue)
+			}
+			heights[y][x] = float64(value) / float64(maxValue)
+		}
+	}
+
+	return heights, nil
+}
+
+//pgmToken reads the next whitespace separated token of a PGM header or plain raster, skipping comments. The single
+//whitespace character following the token is consumed.
+func pgmToken(r *bufio.Reader) (string, error) {
+	var token []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			if err == io.EOF && len(token) > 0 {
+				return string(token), nil
+			}
+			return "", err
+		}
+
+		switch {
+		case b == '#' && len(token) == 0:
+			if _, err := r.ReadString('\n'); err != nil {
+				return "", err
+			}
+		case b == ' ' || b == '\t' || b == '\n' || b == '\r':
+			if len(token) > 0 {
+				return string(token), nil
+			}
+		default:
+			token = append(token, b)
+		}
+	}
+}
+
+//pgmSample reads a single binary sample of a raw PGM, which is two bytes when the maximum value does not fit in one.
+func pgmSample(r *bufio.Reader, maxValue int) (int, error) {
+	if maxValue < 256 {
+		b, err := r.ReadByte()
+		return int(b), err
+	}
+
+	var sample uint16
+	err := binary.Read(r, binary.BigEndian, &sample)
+
+	return int(sample), err
+}
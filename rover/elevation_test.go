@@ -0,0 +1,229 @@
+package rover
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//ramp is a plateau rising by one for every step east, with a cliff of three along its northern edge.
+func ramp() *Plateau {
+	p := &Plateau{}
+	for x := 0; x <= 2; x++ {
+		p.SetElevation(Coordinate{x, 0}, float64(x))
+		p.SetElevation(Coordinate{x, 1}, float64(x))
+		p.SetElevation(Coordinate{x, 2}, float64(x+3))
+	}
+
+	return p
+}
+
+func TestRover_ExploreSlope(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		expErr      error
+		expPosition Position
+		expCharge   float64
+	}{
+		"climbing within the slope limit costs extra energy": {
+			rover: &Rover{
+				Commands: "RMM",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  ramp(),
+				Battery:  &Battery{Capacity: 10, Charge: 10, ClimbCost: 2},
+				MaxSlope: 1,
+			},
+			expPosition: Position{Coordinate{2, 0}, East},
+			expCharge:   3.5,
+		},
+		"descending costs nothing extra": {
+			rover: &Rover{
+				Commands: "MM",
+				Position: &Position{Coordinate{2, 0}, West},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  ramp(),
+				Battery:  &Battery{Capacity: 10, Charge: 10, ClimbCost: 2},
+				MaxSlope: 1,
+			},
+			expPosition: Position{Coordinate{0, 0}, West},
+			expCharge:   8,
+		},
+		"no slope limit climbs anything": {
+			rover: &Rover{
+				Commands: "MM",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  ramp(),
+			},
+			expPosition: Position{Coordinate{0, 2}, North},
+		},
+		"err slope too steep": {
+			rover: &Rover{
+				Commands: "MM",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  ramp(),
+				MaxSlope: 1,
+			},
+			expErr: &MovementError{
+				Step:        1,
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 1}, North},
+				Attempted:   Coordinate{0, 2},
+				Err:         &SlopeError{From: Coordinate{0, 1}, To: Coordinate{0, 2}, Slope: 3, Limit: 1},
+			},
+			expPosition: Position{Coordinate{0, 1}, North},
+		},
+		"err slope too steep to descend": {
+			rover: &Rover{
+				Commands: "M",
+				Position: &Position{Coordinate{2, 2}, South},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  ramp(),
+				MaxSlope: 2,
+			},
+			expErr: &MovementError{
+				Instruction: Move,
+				Position:    Position{Coordinate{2, 2}, South},
+				Attempted:   Coordinate{2, 1},
+				Err:         &SlopeError{From: Coordinate{2, 2}, To: Coordinate{2, 1}, Slope: -3, Limit: 2},
+			},
+			expPosition: Position{Coordinate{2, 2}, South},
+		},
+		"err battery cannot afford the climb": {
+			rover: &Rover{
+				Commands: "RM",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  ramp(),
+				Battery:  &Battery{Capacity: 10, Charge: 2, ClimbCost: 2},
+			},
+			expErr: &MovementError{
+				Step:        1,
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 0}, East},
+				Attempted:   Coordinate{1, 0},
+				Err:         fmt.Errorf("%w : %q costs %g with %g remaining", ErrBatteryDepleted, 'M', 3.0, 1.5),
+			},
+			expPosition: Position{Coordinate{0, 0}, East},
+			expCharge:   1.5,
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Explore()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expPosition, *test.rover.Position, "%s failed, expected position %v but got %v", desc, test.expPosition, *test.rover.Position)
+		assert.Equalf(t, test.expCharge, test.rover.Battery.remaining(), "%s failed, expected charge %g but got %g", desc, test.expCharge, test.rover.Battery.remaining())
+	}
+}
+
+func TestSlopeError_Is(t *testing.T) {
+	r := &Rover{
+		Commands: "MM",
+		Position: &Position{Coordinate{0, 0}, North},
+		Boundary: &Coordinate{2, 2},
+		Plateau:  ramp(),
+		MaxSlope: 1,
+	}
+
+	err := r.Explore()
+	assert.True(t, errors.Is(err, ErrSlopeTooSteep), "expected error to be ErrSlopeTooSteep but got %v", err)
+
+	var slope *SlopeError
+	if assert.True(t, errors.As(err, &slope), "expected error to hold a *SlopeError but got %v", err) {
+		assert.Equal(t, 3.0, slope.Slope)
+	}
+}
+
+func TestPlan_Slope(t *testing.T) {
+	r := &Rover{
+		Position: &Position{Coordinate{0, 1}, North},
+		Boundary: &Coordinate{2, 2},
+		Plateau:  ramp(),
+		MaxSlope: 1,
+	}
+
+	//the cliff along the north edge is too steep to climb from anywhere below it
+	commands, err := Plan(r, Coordinate{1, 2}, UnknownDirection, nil)
+	assert.Equalf(t, ErrTargetUnreachable, errors.Unwrap(err), "expected the cliff to be unreachable but got %v with %s", err, commands)
+}
+
+func TestPlateau_LoadElevation(t *testing.T) {
+	expected := map[Coordinate]float64{
+		{0, 1}: 0, {1, 1}: 5, {2, 1}: 10,
+		{0, 0}: 10, {1, 0}: 5, {2, 0}: 0,
+	}
+
+	gray := image.NewGray(image.Rect(0, 0, 3, 2))
+	for x, y := range []uint8{0, 0x80, 0xff} {
+		gray.SetGray(x, 0, color.Gray{Y: y})
+		gray.SetGray(2-x, 1, color.Gray{Y: y})
+	}
+	var encoded bytes.Buffer
+	if err := png.Encode(&encoded, gray); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		load   func(p *Plateau) error
+		expErr error
+	}{
+		"csv grid": {
+			load: func(p *Plateau) error {
+				return p.LoadElevationCSV(strings.NewReader("0,5,10\n10,5,0\n"), Coordinate{})
+			},
+		},
+		"plain pgm with a comment": {
+			load: func(p *Plateau) error {
+				return p.LoadElevationImage(strings.NewReader("P2\n# ramp\n3 2\n4\n0 2 4\n4 2 0\n"), Coordinate{}, 10)
+			},
+		},
+		"raw pgm": {
+			load: func(p *Plateau) error {
+				return p.LoadElevationImage(strings.NewReader("P5 3 2 4\n\x00\x02\x04\x04\x02\x00"), Coordinate{}, 10)
+			},
+		},
+		"png": {
+			load: func(p *Plateau) error {
+				return p.LoadElevationImage(bytes.NewReader(encoded.Bytes()), Coordinate{}, 10)
+			},
+		},
+		"err csv with a non numeric height": {
+			load: func(p *Plateau) error {
+				return p.LoadElevationCSV(strings.NewReader("0,high,10\n10,5,0\n"), Coordinate{})
+			},
+			expErr: ErrInvalidElevation,
+		},
+		"err pgm value above its maximum": {
+			load: func(p *Plateau) error {
+				return p.LoadElevationImage(strings.NewReader("P2 3 2 4 0 2 5 4 2 0"), Coordinate{}, 10)
+			},
+			expErr: ErrInvalidElevation,
+		},
+	}
+
+	for desc, test := range tests {
+		p := &Plateau{}
+		err := test.load(p)
+		if test.expErr != nil {
+			assert.Truef(t, errors.Is(err, test.expErr), "%s failed, expected %v but got %v", desc, test.expErr, err)
+			continue
+		}
+
+		if assert.NoErrorf(t, err, "%s failed, expected no error but got %v", desc, err) {
+			for c, height := range expected {
+				assert.InDeltaf(t, height, p.Height(c), 0.05, "%s failed, expected height %g at %v but got %g", desc, height, c, p.Height(c))
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package rover
+
+import "fmt"
+
+//MovementError is returned when a Rover cannot perform a movement instruction, such as moving over a boundary.
+//It wraps the error which stopped the Rover, so errors.Is(err, ErrBoundaryNorth) still works.
+type MovementError struct {
+	Rover       *Rover
+	Step        int
+	Instruction Instruction
+	Position    Position
+	Attempted   Coordinate
+	Err         error
+}
+
+func (e *MovementError) Error() string {
+	return fmt.Sprintf("%v at (%d, %d) failed command %d %q moving to (%d, %d) : %v", e.Rover, e.Position.X,
+		e.Position.Y, e.Step, rune(e.Instruction), e.Attempted.X, e.Attempted.Y, e.Err)
+}
+
+func (e *MovementError) Unwrap() error {
+	return e.Err
+}
+
+//InvalidInstructionError is returned when a Rover is given a command which is not a known Instruction.
+//It wraps ErrUnknownInstruction.
+type InvalidInstructionError struct {
+	Rover       *Rover
+	Step        int
+	Instruction Instruction
+	Err         error
+}
+
+func (e *InvalidInstructionError) Error() string {
+	return fmt.Sprintf("%v command %d : %v", e.Rover, e.Step, e.Err)
+}
+
+func (e *InvalidInstructionError) Unwrap() error {
+	return e.Err
+}
+
+//ValidationError is returned by Valid when a Rover is in a non-valid state, it wraps the error describing why.
+type ValidationError struct {
+	Rover *Rover
+	Err   error
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%v is not valid : %v", e.Rover, e.Err)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
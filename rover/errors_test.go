@@ -0,0 +1,51 @@
+package rover
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors_Unwrap(t *testing.T) {
+	tests := map[string]struct {
+		rover    *Rover
+		sentinel error
+		target   interface{}
+	}{
+		"movement error wraps boundary error": {
+			rover:    &Rover{Commands: "MM", Position: &Position{Coordinate{0, 0}, West}, Boundary: &Coordinate{1, 1}},
+			sentinel: ErrBoundaryWest,
+			target:   new(*MovementError),
+		},
+		"invalid instruction error wraps unknown instruction": {
+			rover:    &Rover{Commands: "X", Position: &Position{Coordinate{0, 0}, West}, Boundary: &Coordinate{1, 1}},
+			sentinel: ErrUnknownInstruction,
+			target:   new(*InvalidInstructionError),
+		},
+		"movement error wraps impassable terrain error": {
+			rover: &Rover{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1},
+				Plateau: terrainPlateau(Ice), Traversal: map[Terrain]Traversal{Ice: {Impassable: true}}},
+			sentinel: ErrImpassableTerrain,
+			target:   new(*TerrainError),
+		},
+		"off plateau error wraps boundary error": {
+			rover:    &Rover{Commands: "MM", Position: &Position{Coordinate{0, 0}, West}, Boundary: &Coordinate{1, 1}},
+			sentinel: ErrOffPlateau,
+			target:   new(*OffPlateauError),
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Explore()
+		assert.Truef(t, errors.Is(err, test.sentinel), "%s failed, expected %v to wrap %v", desc, err, test.sentinel)
+		assert.Truef(t, errors.As(err, test.target), "%s failed, expected %v to be a %T", desc, err, test.target)
+	}
+
+	err := (&Rover{Commands: "X", Position: &Position{Coordinate{0, 0}, West}, Boundary: &Coordinate{1, 1}}).Valid()
+	var validation *ValidationError
+	var invalid *InvalidInstructionError
+	assert.Truef(t, errors.As(err, &validation), "expected %v to be a validation error", err)
+	assert.Truef(t, errors.As(err, &invalid), "expected %v to wrap an invalid instruction error", err)
+	assert.Truef(t, errors.Is(err, ErrUnknownInstruction), "expected %v to wrap %v", err, ErrUnknownInstruction)
+}
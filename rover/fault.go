@@ -0,0 +1,150 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+var (
+	ErrMotorStalled     = errors.New("rover motor stalled")
+	ErrUnknownFault     = errors.New("unknown fault")
+	ErrInvalidFaultRate = errors.New("fault probabilities must be between zero and one")
+)
+
+//Fault is a failure injected into a Rover's move or turn.
+type Fault int
+
+const (
+	NoFault Fault = iota
+	//SkippedMove is a move which does not happen, the Rover stays where it is without an error.
+	SkippedMove
+	//Overshoot is a turn which carries on a further turn in the same direction.
+	Overshoot
+	//Stall is a motor stalling on a move or a turn, the Rover stops with ErrMotorStalled.
+	Stall
+)
+
+var faultNames = map[Fault]string{
+	NoFault:     "none",
+	SkippedMove: "skipped move",
+	Overshoot:   "overshoot",
+	Stall:       "stall",
+}
+
+//faultOrder is the order the probabilities of each Fault are added up in when rolling for a Fault.
+var faultOrder = []Fault{SkippedMove, Overshoot, Stall}
+
+func (f Fault) String() string {
+	if name, ok := faultNames[f]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Fault(%d)", int(f))
+}
+
+//applies reports whether the Fault can happen to the Instruction.
+func (f Fault) applies(i Instruction) bool {
+	switch f {
+	case SkippedMove:
+		return i == Move
+	case Overshoot:
+		return i == TurnLeft || i == TurnRight
+	case Stall:
+		return i == Move || i == TurnLeft || i == TurnRight
+	}
+
+	return false
+}
+
+//FaultRates is the probability of each Fault happening.
+type FaultRates map[Fault]float64
+
+//Faults injects Faults into the moves and turns of a Mission's Rovers. The probability of a Fault is the sum of its
+//rate for the Instruction and its rate for the Terrain the Rover is on, Faults which cannot happen to an Instruction
+//are ignored. The random numbers are seeded, and reseeded every time the Mission runs, so the same Mission always
+//fails in the same way.
+type Faults struct {
+	Seed         int64
+	Instructions map[Instruction]FaultRates
+	Terrain      map[Terrain]FaultRates
+	rng          *rand.Rand
+}
+
+//Valid will return an error if any Fault is unknown or any probability is outside zero and one.
+func (f *Faults) Valid() error {
+	if f == nil {
+		return nil
+	}
+
+	for instruction, rates := range f.Instructions {
+		if err := instruction.Valid(); err != nil {
+			return err
+		}
+
+		if err := rates.valid(); err != nil {
+			return fmt.Errorf("%w : %q", err, rune(instruction))
+		}
+	}
+
+	for terrain, rates := range f.Terrain {
+		if err := terrain.Valid(); err != nil {
+			return err
+		}
+
+		if err := rates.valid(); err != nil {
+			return fmt.Errorf("%w : %v", err, terrain)
+		}
+	}
+
+	return nil
+}
+
+func (rates FaultRates) valid() error {
+	for fault, rate := range rates {
+		if _, ok := faultNames[fault]; !ok || fault == NoFault {
+			return fmt.Errorf("%w %d", ErrUnknownFault, int(fault))
+		}
+
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("%w : %v %g", ErrInvalidFaultRate, fault, rate)
+		}
+	}
+
+	return nil
+}
+
+//reset reseeds the random numbers, so that the Faults replay from the start.
+func (f *Faults) reset() {
+	if f != nil {
+		f.rng = rand.New(rand.NewSource(f.Seed))
+	}
+}
+
+//roll returns the Fault, if any, to inject into the Rover's Instruction. A single random number is drawn for every
+//Instruction, whether or not it can fault, so that a replay draws the same numbers in the same order.
+func (f *Faults) roll(r *Rover, i Instruction) Fault {
+	if f == nil {
+		return NoFault
+	}
+	if f.rng == nil {
+		f.reset()
+	}
+
+	roll := f.rng.Float64()
+	terrain := r.Plateau.TerrainAt(r.Position.Coordinate)
+
+	var chance float64
+	for _, fault := range faultOrder {
+		if !fault.applies(i) {
+			continue
+		}
+
+		chance += f.Instructions[i][fault] + f.Terrain[terrain][fault]
+		if roll < chance {
+			return fault
+		}
+	}
+
+	return NoFault
+}
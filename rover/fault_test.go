@@ -0,0 +1,150 @@
+package rover
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMission_RunFaults(t *testing.T) {
+	tests := map[string]struct {
+		mission      *Mission
+		expErr       error
+		expFaults    [][]Fault
+		expPositions []Position
+	}{
+		"skipped moves leave the rover where it is": {
+			mission: &Mission{
+				Faults: &Faults{Instructions: map[Instruction]FaultRates{Move: {SkippedMove: 1}}},
+				Rovers: Rovers{
+					{Commands: "MRM", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expFaults:    [][]Fault{{SkippedMove}, {NoFault}, {SkippedMove}},
+			expPositions: []Position{{Coordinate{0, 0}, East}},
+		},
+		"turns overshoot by a further quarter turn": {
+			mission: &Mission{
+				Faults: &Faults{Instructions: map[Instruction]FaultRates{TurnRight: {Overshoot: 1}}},
+				Rovers: Rovers{
+					{Commands: "RLM", Position: &Position{Coordinate{1, 1}, North}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expFaults:    [][]Fault{{Overshoot}, {NoFault}, {NoFault}},
+			expPositions: []Position{{Coordinate{2, 1}, East}},
+		},
+		"err motor stalls on sand": {
+			mission: &Mission{
+				Faults: &Faults{Terrain: map[Terrain]FaultRates{Sand: {Stall: 1}}},
+				Rovers: Rovers{
+					{Commands: "MMR", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+						Plateau: terrainPlateau(Sand)},
+				},
+			},
+			expErr: &MovementError{
+				Step:        1,
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 1}, North},
+				Attempted:   Coordinate{0, 2},
+				Err:         ErrMotorStalled,
+			},
+			expFaults:    [][]Fault{{NoFault}, {Stall}},
+			expPositions: []Position{{Coordinate{0, 1}, North}},
+		},
+		"lockstep rover does not move into a rover whose move is skipped": {
+			mission: &Mission{
+				Mode:   Lockstep,
+				Faults: &Faults{Terrain: map[Terrain]FaultRates{Sand: {SkippedMove: 1}}},
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 1}, East}, Boundary: &Coordinate{2, 2},
+						Plateau: terrainPlateau(Sand)},
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+						Plateau: terrainPlateau(Sand)},
+				},
+			},
+			expErr:       ErrLockstepDeadlock,
+			expFaults:    [][]Fault{{SkippedMove, NoFault}, {NoFault}},
+			expPositions: []Position{{Coordinate{0, 1}, East}, {Coordinate{0, 0}, North}},
+		},
+	}
+
+	for desc, test := range tests {
+		trace, err := test.mission.Run()
+		if movement, ok := test.expErr.(*MovementError); ok {
+			movement.Rover = test.mission.Rovers[0]
+		}
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+
+		faults := make([][]Fault, len(trace.Ticks))
+		for i, tick := range trace.Ticks {
+			for _, step := range tick.Steps {
+				faults[i] = append(faults[i], step.Fault)
+			}
+		}
+		assert.Equalf(t, test.expFaults, faults, "%s failed, expected faults %v but got %v", desc, test.expFaults, faults)
+
+		for i, r := range test.mission.Rovers {
+			assert.Equalf(t, test.expPositions[i], *r.Position, "%s failed, expected rover %d at %v but got %v", desc, i, test.expPositions[i], *r.Position)
+		}
+	}
+}
+
+func TestMission_RunFaultsReplay(t *testing.T) {
+	run := func(faults *Faults) ([]Fault, Position) {
+		m := &Mission{
+			Faults: faults,
+			Rovers: Rovers{
+				{Commands: "MRMLMRMLMRRMMLLM", Position: &Position{Coordinate{2, 2}, North}, Boundary: &Coordinate{5, 5}},
+			},
+		}
+
+		trace, _ := m.Run()
+		var injected []Fault
+		for _, tick := range trace.Ticks {
+			injected = append(injected, tick.Steps[0].Fault)
+		}
+
+		return injected, *m.Rovers[0].Position
+	}
+
+	rates := map[Instruction]FaultRates{
+		Move:      {SkippedMove: 0.3},
+		TurnLeft:  {Overshoot: 0.3},
+		TurnRight: {Overshoot: 0.3},
+	}
+
+	faults := &Faults{Seed: 42, Instructions: rates}
+	first, firstPosition := run(faults)
+	second, secondPosition := run(&Faults{Seed: 42, Instructions: rates})
+	assert.Equal(t, first, second, "expected the same seed to inject the same faults")
+	assert.Equal(t, firstPosition, secondPosition, "expected the same seed to finish in the same position")
+	assert.Contains(t, first, SkippedMove, "expected some moves to be skipped")
+
+	again, _ := run(faults)
+	assert.Equal(t, first, again, "expected the faults to replay when rerun")
+}
+
+func TestFaults_Valid(t *testing.T) {
+	tests := map[string]struct {
+		faults *Faults
+		expErr error
+	}{
+		"no faults are valid": {},
+		"rates within zero and one are valid": {
+			faults: &Faults{
+				Instructions: map[Instruction]FaultRates{Move: {SkippedMove: 0.5, Stall: 0.5}},
+				Terrain:      map[Terrain]FaultRates{Ice: {Overshoot: 1}},
+			},
+		},
+		"err rate above one": {
+			faults: &Faults{Instructions: map[Instruction]FaultRates{Move: {Stall: 1.5}}},
+			expErr: fmt.Errorf("%w : %q", fmt.Errorf("%w : %v %g", ErrInvalidFaultRate, Stall, 1.5), 'M'),
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.faults.Valid()
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+	}
+}
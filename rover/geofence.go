@@ -0,0 +1,107 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrGeofenceViolation = errors.New("rover cannot enter an active geofence")
+	ErrInvalidGeofence   = errors.New("invalid geofence")
+)
+
+//Geofence closes an area of the plateau to Rovers from its Start tick to its End tick inclusive. A Start of zero closes
+//the area from the beginning of the Mission and an End of zero leaves it closed for good.
+type Geofence struct {
+	Name  string
+	Shape Shape
+	Start int
+	End   int
+}
+
+//Valid returns an ErrInvalidGeofence if the Geofence has no Shape, a negative tick or ends before it starts, or an
+//ErrInvalidShape if its Shape is not valid.
+func (g Geofence) Valid() error {
+	if g.Shape == nil {
+		return fmt.Errorf("%w : %s has no shape", ErrInvalidGeofence, g.Name)
+	}
+
+	if g.Start < 0 || g.End < 0 || (g.End != 0 && g.End < g.Start) {
+		return fmt.Errorf("%w : %s from tick %d to %d", ErrInvalidGeofence, g.Name, g.Start, g.End)
+	}
+
+	return g.Shape.Valid()
+}
+
+//Active reports whether the Geofence is closed on the given tick.
+func (g Geofence) Active(tick int) bool {
+	return tick >= g.Start && (g.End == 0 || tick <= g.End)
+}
+
+//GeofenceError is returned, wrapped in a *MovementError, when a Rover tries to move into an active Geofence.
+//It unwraps to ErrGeofenceViolation.
+type GeofenceError struct {
+	Coordinate Coordinate
+	Fence      string
+	Tick       int
+}
+
+func (e *GeofenceError) Error() string {
+	return fmt.Sprintf("%v : %s at (%d, %d) on tick %d", ErrGeofenceViolation, e.Fence, e.Coordinate.X,
+		e.Coordinate.Y, e.Tick)
+}
+
+func (e *GeofenceError) Unwrap() error {
+	return ErrGeofenceViolation
+}
+
+//AddGeofence closes the area of the Geofence to Rovers while it is active.
+func (p *Plateau) AddGeofence(g Geofence) {
+	p.Geofences = append(p.Geofences, g)
+}
+
+//Fenced returns the first Geofence covering the Coordinate which is active on the Plateau's current Tick.
+func (p *Plateau) Fenced(c Coordinate) (Geofence, bool) {
+	if p == nil {
+		return Geofence{}, false
+	}
+
+	for _, g := range p.Geofences {
+		if g.Active(p.Tick) && g.Shape.Contains(c) {
+			return g, true
+		}
+	}
+
+	return Geofence{}, false
+}
+
+//closing returns the first Geofence covering the Coordinate which is active on the Plateau's current Tick or any later
+//tick, so planners keep clear of areas which close before a plan could finish.
+func (p *Plateau) closing(c Coordinate) (Geofence, bool) {
+	if p == nil {
+		return Geofence{}, false
+	}
+
+	for _, g := range p.Geofences {
+		if (g.End == 0 || g.End >= p.Tick) && g.Shape.Contains(c) {
+			return g, true
+		}
+	}
+
+	return Geofence{}, false
+}
+
+//validGeofences returns the error of the first Geofence of the Plateau which is not valid.
+func (p *Plateau) validGeofences() error {
+	if p == nil {
+		return nil
+	}
+
+	for _, g := range p.Geofences {
+		if err := g.Valid(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//blastZone closes (0, 1) and (1, 1) from the start tick to the end tick.
+func blastZone(start, end int) *Plateau {
+	return &Plateau{Geofences: []Geofence{{Name: "blast", Shape: Rectangle{Coordinate{0, 1}, Coordinate{1, 1}}, Start: start, End: end}}}
+}
+
+func TestGeofence_Valid(t *testing.T) {
+	tests := map[string]struct {
+		fence  Geofence
+		expErr error
+	}{
+		"rectangle active for good": {
+			fence: Geofence{Name: "lander", Shape: Rectangle{Coordinate{0, 0}, Coordinate{1, 1}}},
+		},
+		"polygon active for a window": {
+			fence: Geofence{Name: "dust", Shape: Polygon{{0, 0}, {2, 0}, {0, 2}}, Start: 3, End: 5},
+		},
+		"err without a shape": {
+			fence:  Geofence{Name: "lander"},
+			expErr: fmt.Errorf("%w : %s has no shape", ErrInvalidGeofence, "lander"),
+		},
+		"err ends before it starts": {
+			fence:  Geofence{Name: "dust", Shape: Rectangle{Coordinate{0, 0}, Coordinate{1, 1}}, Start: 5, End: 3},
+			expErr: fmt.Errorf("%w : %s from tick %d to %d", ErrInvalidGeofence, "dust", 5, 3),
+		},
+		"err rectangle corners reversed": {
+			fence:  Geofence{Name: "lander", Shape: Rectangle{Coordinate{1, 1}, Coordinate{0, 0}}},
+			expErr: fmt.Errorf("%w : rectangle from (%d, %d) to (%d, %d)", ErrInvalidShape, 1, 1, 0, 0),
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.fence.Valid()
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+	}
+}
+
+func TestRover_ExploreGeofence(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		tick        int
+		expErr      error
+		expPosition Position
+	}{
+		"fence without a window is always active": {
+			rover: &Rover{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+				Plateau: blastZone(0, 0)},
+			expErr: &MovementError{
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 0}, North},
+				Attempted:   Coordinate{0, 1},
+				Err:         &GeofenceError{Coordinate: Coordinate{0, 1}, Fence: "blast"},
+			},
+			expPosition: Position{Coordinate{0, 0}, North},
+		},
+		"fence not yet active": {
+			rover: &Rover{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+				Plateau: blastZone(3, 0)},
+			tick:        1,
+			expPosition: Position{Coordinate{0, 1}, North},
+		},
+		"fence no longer active": {
+			rover: &Rover{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+				Plateau: blastZone(1, 2)},
+			tick:        2,
+			expPosition: Position{Coordinate{0, 1}, North},
+		},
+		"err fence opens as the rover turns": {
+			rover: &Rover{Commands: "RLM", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+				Plateau: blastZone(3, 0)},
+			expErr: &MovementError{
+				Step:        2,
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 0}, North},
+				Attempted:   Coordinate{0, 1},
+				Err:         &GeofenceError{Coordinate: Coordinate{0, 1}, Fence: "blast"},
+			},
+			expPosition: Position{Coordinate{0, 0}, North},
+		},
+		"rover may leave an active fence": {
+			rover: &Rover{Commands: "M", Position: &Position{Coordinate{0, 1}, North}, Boundary: &Coordinate{2, 2},
+				Plateau: blastZone(0, 0)},
+			expPosition: Position{Coordinate{0, 2}, North},
+		},
+	}
+
+	for desc, test := range tests {
+		test.rover.Plateau.Tick = test.tick
+		err := test.rover.Explore()
+		if movement, ok := test.expErr.(*MovementError); ok {
+			movement.Err.(*GeofenceError).Tick = test.tick + movement.Step + 1
+		}
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expPosition, *test.rover.Position, "%s failed, expected position %v but got %v", desc, test.expPosition, *test.rover.Position)
+		assert.Equalf(t, test.tick, test.rover.Plateau.Tick, "%s failed, expected the plateau back on tick %d but got %d", desc, test.tick, test.rover.Plateau.Tick)
+	}
+}
+
+func TestRover_ExploreAfterMission(t *testing.T) {
+	plateau := blastZone(1, 2)
+	mission := &Mission{Rovers: Rovers{
+		{Commands: "RLLR", Position: &Position{Coordinate{2, 2}, North}, Boundary: &Coordinate{2, 2}, Plateau: plateau},
+	}}
+
+	_, err := mission.Run()
+	assert.Nilf(t, err, "expected the mission to run but got %v", err)
+	assert.Equalf(t, 0, plateau.Tick, "expected the mission to leave the plateau on tick 0 but got %d", plateau.Tick)
+
+	//the fence opened and closed again during the mission, but is active for a rover exploring from the start
+	r := &Rover{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}, Plateau: plateau}
+	expErr := withRover(&MovementError{
+		Instruction: Move,
+		Position:    Position{Coordinate{0, 0}, North},
+		Attempted:   Coordinate{0, 1},
+		Err:         &GeofenceError{Coordinate: Coordinate{0, 1}, Fence: "blast", Tick: 1},
+	}, r)
+	err = r.Explore()
+	assert.Equalf(t, expErr, err, "expected %v but got %v", expErr, err)
+}
+
+func TestMission_RunGeofence(t *testing.T) {
+	tests := map[string]struct {
+		mission     *Mission
+		expErr      error
+		expPosition Position
+	}{
+		"fence closes after the rover has passed": {
+			mission: &Mission{Rovers: Rovers{
+				{Commands: "MM", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+					Plateau: blastZone(2, 0)},
+			}},
+			expPosition: Position{Coordinate{0, 2}, North},
+		},
+		"err fence closes before the rover arrives": {
+			mission: &Mission{Rovers: Rovers{
+				{Commands: "RLM", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+					Plateau: blastZone(3, 0)},
+			}},
+			expErr:      ErrGeofenceViolation,
+			expPosition: Position{Coordinate{0, 0}, North},
+		},
+		"fence ends in time for lockstep rovers": {
+			mission: &Mission{Mode: Lockstep, Rovers: Rovers{
+				{Commands: "RLRLM", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+					Plateau: blastZone(0, 4)},
+			}},
+			expPosition: Position{Coordinate{0, 1}, North},
+		},
+	}
+
+	for desc, test := range tests {
+		_, err := test.mission.Run()
+		assert.Truef(t, errors.Is(err, test.expErr), "%s failed, expected %v but got %v", desc, test.expErr, err)
+		if test.expErr != nil {
+			var fenced *GeofenceError
+			assert.Truef(t, errors.As(err, &fenced), "%s failed, expected a geofence error but got %v", desc, err)
+		}
+		position := *test.mission.Rovers[0].Position
+		assert.Equalf(t, test.expPosition, position, "%s failed, expected position %v but got %v", desc, test.expPosition, position)
+	}
+}
+
+func TestPlan_Geofence(t *testing.T) {
+	//the fence closes later on, so plans keep clear of it from the start
+	r := &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}, Plateau: blastZone(10, 20)}
+
+	commands, err := Plan(r, Coordinate{0, 2}, North, nil)
+	assert.Nilf(t, err, "expected a plan around the fence but got %v", err)
+	assert.Equalf(t, "RMMLMMLMMR", commands, "expected to route around the fence")
+
+	r.Plateau.Tick = 21
+	commands, err = Plan(r, Coordinate{0, 2}, North, nil)
+	assert.Nilf(t, err, "expected a plan through the closed fence but got %v", err)
+	assert.Equalf(t, "MM", commands, "expected to route through the fence once it has ended")
+}
@@ -0,0 +1,95 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrUnknownGrid = errors.New("unknown grid")
+
+//Grid describes the cells a plateau is divided into.
+type Grid uint8
+
+const (
+	//Square cells each have four neighbours, one for each cardinal Direction.
+	Square Grid = iota
+	//Hex cells are pointed at the top and each have six neighbours, one for each Direction of hexCompass. Coordinates
+	//are axial, X counts cells east along a row and Y counts rows north, so the north-east neighbour of (x, y) is
+	//(x, y+1) and its north-west neighbour is (x-1, y+1).
+	Hex
+)
+
+//hexCompass holds the six Directions of a Hex Grid, clockwise from NorthEast.
+var hexCompass = []Direction{NorthEast, East, SouthEast, SouthWest, West, NorthWest}
+
+//Valid will return an error if the Grid is not Square or Hex.
+func (g Grid) Valid() error {
+	switch g {
+	case Square:
+	case Hex:
+	default:
+		return fmt.Errorf("%w Grid{%d}", ErrUnknownGrid, g)
+	}
+
+	return nil
+}
+
+//Headings returns the Directions a Rover can face on the Grid, clockwise.
+func (g Grid) Headings() []Direction {
+	if g == Hex {
+		return hexCompass
+	}
+
+	return compass
+}
+
+//Heading will return an error if the Direction is not one of the Grid's Headings.
+func (g Grid) Heading(d Direction) error {
+	if g.turns(d) < 0 {
+		return errUnknownDirection(d)
+	}
+
+	return nil
+}
+
+//turns returns how many right turns from the first of the Grid's Headings the Direction is, or -1 if the Direction
+//is not a Heading of the Grid.
+func (g Grid) turns(d Direction) int {
+	for i, heading := range g.Headings() {
+		if heading == d {
+			return i
+		}
+	}
+
+	return -1
+}
+
+//reverse returns the opposite of the Direction on the Grid, or the Direction itself if it is not one of the Grid's
+//Headings.
+func (g Grid) reverse(d Direction) Direction {
+	headings := g.Headings()
+	at := g.turns(d)
+	if at < 0 {
+		return d
+	}
+
+	return headings[(at+len(headings)/2)%len(headings)]
+}
+
+//point returns where the centre of the cell at the Coordinate lies on a flat map, with neighbouring cells one apart.
+func (g Grid) point(c Coordinate) (float64, float64) {
+	if g == Hex {
+		return float64(c.X) + float64(c.Y)/2, float64(c.Y) * math.Sqrt(3) / 2
+	}
+
+	return float64(c.X), float64(c.Y)
+}
+
+//distance returns how far apart the centres of the cells at the Coordinates are, in cells.
+func (g Grid) distance(a, b Coordinate) float64 {
+	ax, ay := g.point(a)
+	bx, by := g.point(b)
+
+	return math.Hypot(bx-ax, by-ay)
+}
@@ -0,0 +1,116 @@
+package rover
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRover_ExploreHex(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		expErr      error
+		expPosition Position
+	}{
+		"six turns right come full circle": {
+			rover:       &Rover{Grid: Hex, Commands: "RRRRRR", Position: &Position{Coordinate{1, 1}, NorthEast}, Boundary: &Coordinate{2, 2}},
+			expPosition: Position{Coordinate{1, 1}, NorthEast},
+		},
+		"turns rotate a sixth at a time": {
+			rover:       &Rover{Grid: Hex, Commands: "LL", Position: &Position{Coordinate{1, 1}, NorthEast}, Boundary: &Coordinate{2, 2}},
+			expPosition: Position{Coordinate{1, 1}, West},
+		},
+		"a hexagon of moves returns to the start": {
+			rover:       &Rover{Grid: Hex, Commands: "MRMRMRMRMRMR", Position: &Position{Coordinate{1, 0}, NorthWest}, Boundary: &Coordinate{2, 2}},
+			expPosition: Position{Coordinate{1, 0}, NorthWest},
+		},
+		"north-west along the axial diagonal": {
+			rover:       &Rover{Grid: Hex, Commands: "MM", Position: &Position{Coordinate{2, 0}, NorthWest}, Boundary: &Coordinate{2, 2}},
+			expPosition: Position{Coordinate{0, 2}, NorthWest},
+		},
+		"err south-east over the southern boundary": {
+			rover: &Rover{Grid: Hex, Commands: "M", Position: &Position{Coordinate{0, 0}, SouthEast}, Boundary: &Coordinate{2, 2}},
+			expErr: &MovementError{
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 0}, SouthEast},
+				Attempted:   Coordinate{1, -1},
+				Err:         &OffPlateauError{Coordinate: Coordinate{1, -1}, Err: ErrBoundarySouth},
+			},
+			expPosition: Position{Coordinate{0, 0}, SouthEast},
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Explore()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expPosition, *test.rover.Position, "%s failed, expected position %v but got %v", desc, test.expPosition, *test.rover.Position)
+	}
+}
+
+func TestRover_ValidGrid(t *testing.T) {
+	tests := map[string]struct {
+		rover  *Rover
+		expErr error
+	}{
+		"hex heading on a hex grid": {
+			rover: &Rover{Grid: Hex, Commands: "M", Position: &Position{Coordinate{0, 0}, SouthWest}, Boundary: &Coordinate{1, 1}},
+		},
+		"err north on a hex grid": {
+			rover:  &Rover{Grid: Hex, Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1}},
+			expErr: &ValidationError{Err: errUnknownDirection(North)},
+		},
+		"err hex heading on a square grid": {
+			rover:  &Rover{Commands: "M", Position: &Position{Coordinate{0, 0}, NorthEast}, Boundary: &Coordinate{1, 1}},
+			expErr: &ValidationError{Err: errUnknownDirection(NorthEast)},
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Valid()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+	}
+}
+
+func TestGrid_distance(t *testing.T) {
+	//every neighbour of a hex cell is one cell away, as is every cardinal neighbour of a square cell
+	for _, grid := range []Grid{Square, Hex} {
+		for _, d := range grid.Headings() {
+			next, _ := Position{Direction: d}.ahead()
+			got := grid.distance(Coordinate{}, next)
+			assert.InDeltaf(t, 1, got, 1e-9, "expected %v to be one cell away on grid %d but got %g", next, grid, got)
+		}
+	}
+
+	got := Hex.distance(Coordinate{0, 0}, Coordinate{1, 1})
+	assert.InDeltaf(t, math.Sqrt(3), got, 1e-9, "expected the hex cell two rows up and one along to be root 3 away but got %g", got)
+}
+
+func TestPlan_Hex(t *testing.T) {
+	r := &Rover{Grid: Hex, Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{3, 3},
+		Plateau: &Plateau{Obstacles: map[Coordinate]bool{{1, 1}: true}}}
+
+	commands, err := Plan(r, Coordinate{1, 2}, NorthWest, nil)
+	if assert.Nilf(t, err, "expected a plan but got %v", err) {
+		assert.Lenf(t, commands, 6, "expected the shortest plan but got %s", commands)
+
+		r.Commands = commands
+		assert.Nilf(t, r.Explore(), "expected planned commands %s to explore cleanly", commands)
+		assert.Equalf(t, Position{Coordinate{1, 2}, NorthWest}, *r.Position, "expected planned commands %s to reach the target", commands)
+	}
+}
+
+func TestRovers_RenderHex(t *testing.T) {
+	rovers := Rovers{
+		{Grid: Hex, Position: &Position{Coordinate{0, 0}, NorthEast}, Boundary: &Coordinate{2, 1},
+			Plateau: &Plateau{Obstacles: map[Coordinate]bool{{1, 1}: true}}},
+		{Grid: Hex, Position: &Position{Coordinate{2, 0}, West}, Boundary: &Coordinate{2, 1}},
+	}
+
+	exp := " . # .\n" +
+		"↗ . <\n"
+	rendered := rovers.Render()
+	assert.Equalf(t, exp, rendered, "expected\n%s\nbut got\n%s", exp, rendered)
+}
@@ -0,0 +1,168 @@
+package rover
+
+import (
+	"errors"
+	"sync"
+)
+
+var ErrHandleClosed = errors.New("rover handle is closed")
+
+//Handle wraps a Rover so that it can be shared between goroutines. Commands submitted to the Handle are queued and
+//executed in order by a single worker goroutine, while the Rover's Position can be read at any time. Only the worker
+//touches the Rover, everyone else reads the snapshot of its Position and charge taken after each instruction.
+type Handle struct {
+	mu       sync.Mutex
+	cond     *sync.Cond
+	rover    Rover
+	position Position
+	charge   float64
+	commands string
+	queue    []Instruction
+	done     int
+	busy     bool
+	closed   bool
+	err      error
+}
+
+//NewHandle takes a copy of the Rover and starts executing its commands, the Rover passed in is not modified.
+//The Rover must be within its boundaries and facing a valid direction, but does not need any commands.
+func NewHandle(r *Rover) (*Handle, error) {
+	if err := r.validPlacement(); err != nil {
+		return nil, err
+	}
+
+	h := &Handle{
+		rover: *r.clone(),
+	}
+	h.rover.Commands = ""
+	h.snapshot()
+	h.cond = sync.NewCond(&h.mu)
+
+	if err := h.Submit(r.Commands); err != nil {
+		return nil, err
+	}
+
+	go h.run()
+
+	return h, nil
+}
+
+//Submit validates the commands and adds them to the end of the queue, it is safe to call from many goroutines.
+//Commands submitted together are executed together, without commands from other calls in between.
+func (h *Handle) Submit(commands string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	instructions, err := h.rover.decode(commands)
+	if err != nil {
+		if invalid, ok := err.(*InvalidInstructionError); ok {
+			invalid.Step += h.done + len(h.queue)
+		}
+		return err
+	}
+
+	if h.closed {
+		return ErrHandleClosed
+	}
+
+	h.queue = append(h.queue, instructions...)
+	h.commands += commands
+	h.cond.Broadcast()
+
+	return nil
+}
+
+//Charge returns a snapshot of the charge remaining in the Rover's Battery, taken between instructions. Rovers without
+//a Battery have no charge.
+func (h *Handle) Charge() float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.charge
+}
+
+//Position returns a snapshot of the Rover's Position, taken between instructions.
+func (h *Handle) Position() Position {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.position
+}
+
+//Commands returns every command submitted to the Handle so far, including those still queued.
+func (h *Handle) Commands() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return h.commands
+}
+
+//Wait blocks until the queue has drained, returning the error of the first instruction that failed since the last
+//call to Wait. When an instruction fails, the commands queued behind it are discarded.
+func (h *Handle) Wait() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for len(h.queue) > 0 || h.busy {
+		h.cond.Wait()
+	}
+
+	err := h.err
+	h.err = nil
+
+	return err
+}
+
+//Close stops the Handle accepting commands, waits for the queue to drain and stops the worker goroutine.
+func (h *Handle) Close() error {
+	h.mu.Lock()
+	h.closed = true
+	h.cond.Broadcast()
+	h.mu.Unlock()
+
+	return h.Wait()
+}
+
+//run executes queued instructions until the Handle is closed. The lock is only held to take the next instruction and
+//to record its outcome, so Position and Submit never wait for an instruction to finish executing.
+func (h *Handle) run() {
+	for {
+		h.mu.Lock()
+		for len(h.queue) == 0 && !h.closed {
+			h.cond.Wait()
+		}
+
+		if len(h.queue) == 0 {
+			h.mu.Unlock()
+			return
+		}
+
+		instruction, step := h.queue[0], h.done
+		h.queue = h.queue[1:]
+		h.done++
+		h.busy = true
+		h.mu.Unlock()
+
+		err := h.rover.execute(step, instruction)
+
+		h.mu.Lock()
+		h.busy = false
+		h.snapshot()
+		if err != nil {
+			if h.err == nil {
+				h.err = err
+			}
+			h.done += len(h.queue)
+			h.queue = nil
+		}
+
+		h.cond.Broadcast()
+		h.mu.Unlock()
+	}
+}
+
+//snapshot records the Rover's Position and charge for readers, the lock must be held.
+func (h *Handle) snapshot() {
+	h.position = *h.rover.Position
+	h.charge = h.rover.Battery.remaining()
+}
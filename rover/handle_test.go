@@ -0,0 +1,132 @@
+package rover
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandle_Submit(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		submit      []string
+		expErr      error
+		expPosition Position
+	}{
+		"executes initial and submitted commands in order": {
+			rover:       &Rover{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			submit:      []string{"R", "MM"},
+			expPosition: Position{Coordinate{2, 1}, East},
+		},
+		"handle does not require initial commands": {
+			rover:       &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			submit:      []string{"LL"},
+			expPosition: Position{Coordinate{0, 0}, South},
+		},
+		"err discards queued commands after a failed instruction": {
+			rover:       &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1}},
+			submit:      []string{"MMR"},
+			expErr:      ErrBoundaryNorth,
+			expPosition: Position{Coordinate{0, 1}, North},
+		},
+	}
+
+	for desc, test := range tests {
+		h, err := NewHandle(test.rover)
+		assert.Nilf(t, err, "%s failed, expected nil error but got %v", desc, err)
+
+		for _, commands := range test.submit {
+			err := h.Submit(commands)
+			assert.Nilf(t, err, "%s failed, expected nil error but got %v", desc, err)
+		}
+
+		err = h.Wait()
+		assert.Truef(t, errors.Is(err, test.expErr), "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expPosition, h.Position(), "%s failed, expected position %v but got %v", desc, test.expPosition, h.Position())
+		assert.Nilf(t, h.Close(), "%s failed, expected nil error on close", desc)
+	}
+}
+
+func TestHandle_SubmitAfterClose(t *testing.T) {
+	h, err := NewHandle(&Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1}})
+	assert.Nil(t, err)
+	assert.Nil(t, h.Close())
+
+	err = h.Submit("M")
+	assert.Equalf(t, ErrHandleClosed, err, "expected %v but got %v", ErrHandleClosed, err)
+}
+
+func TestHandle_Concurrent(t *testing.T) {
+	h, err := NewHandle(&Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{5, 5}})
+	assert.Nil(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			assert.Nil(t, h.Submit("RRRR"))
+		}()
+		go func() {
+			defer wg.Done()
+			position := h.Position()
+			assert.Equalf(t, Coordinate{0, 0}, position.Coordinate, "expected rover to stay at (0, 0) but got %v", position.Coordinate)
+		}()
+	}
+	wg.Wait()
+
+	assert.Nil(t, h.Close())
+	assert.Equal(t, Position{Coordinate{0, 0}, North}, h.Position())
+	assert.Len(t, h.Commands(), 80)
+}
+
+//gate is a Shape covering everywhere, which holds up the first Rover to ask about its Coordinate until it is opened.
+type gate struct {
+	at      Coordinate
+	once    sync.Once
+	reached chan struct{}
+	open    chan struct{}
+}
+
+func (g *gate) Contains(c Coordinate) bool {
+	if c == g.at {
+		g.once.Do(func() {
+			close(g.reached)
+			<-g.open
+		})
+	}
+
+	return true
+}
+
+func (g *gate) Valid() error {
+	return nil
+}
+
+func TestHandle_PositionWhileExecuting(t *testing.T) {
+	g := &gate{at: Coordinate{0, 2}, reached: make(chan struct{}), open: make(chan struct{})}
+	h, err := NewHandle(&Rover{Commands: "MM", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{0, 2},
+		Plateau: &Plateau{Shape: g}})
+	assert.Nil(t, err)
+
+	//the second move is held up part way through, and another goroutine sees where the first one left the rover
+	<-g.reached
+	position := make(chan Position, 1)
+	go func() {
+		position <- h.Position()
+	}()
+	select {
+	case p := <-position:
+		assert.Equalf(t, Position{Coordinate{0, 1}, North}, p, "expected the rover part way along but got %v", p)
+	case <-time.After(time.Second):
+		t.Error("expected to read the position while the rover was moving")
+	}
+
+	close(g.open)
+	assert.Nil(t, h.Wait())
+	assert.Equal(t, Position{Coordinate{0, 2}, North}, h.Position())
+	assert.Nil(t, h.Close())
+}
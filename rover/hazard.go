@@ -0,0 +1,130 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrHazardCollision = errors.New("rover collided with a moving hazard")
+	ErrInvalidHazard   = errors.New("invalid hazard")
+)
+
+//Hazard is an obstacle which drifts over the plateau, such as a dust devil. Path holds its Coordinate on each tick,
+//starting with the first tick of a Mission. A Hazard which Repeats starts its Path again once it reaches the end,
+//otherwise it stays at the last Coordinate of its Path.
+type Hazard struct {
+	Name   string
+	Path   []Coordinate
+	Repeat bool
+}
+
+//Valid returns an ErrInvalidHazard if the Hazard has no Path.
+func (h Hazard) Valid() error {
+	if len(h.Path) == 0 {
+		return fmt.Errorf("%w : %s has no path", ErrInvalidHazard, h.Name)
+	}
+
+	return nil
+}
+
+//At returns the Coordinate of the Hazard on the given tick. Before the first tick it is at the start of its Path.
+func (h Hazard) At(tick int) Coordinate {
+	i := tick - 1
+	switch {
+	case i < 0:
+		i = 0
+	case i >= len(h.Path) && h.Repeat:
+		i %= len(h.Path)
+	case i >= len(h.Path):
+		i = len(h.Path) - 1
+	}
+
+	return h.Path[i]
+}
+
+//CollisionError is returned when a Rover and a Hazard meet. Wrapped in a *MovementError it is a Rover moving onto a
+//Hazard, otherwise the Hazard ran into a Rover which did not move out of its way and Rover is set.
+//It unwraps to ErrHazardCollision.
+type CollisionError struct {
+	Rover      *Rover
+	Hazard     string
+	Coordinate Coordinate
+	Tick       int
+}
+
+func (e *CollisionError) Error() string {
+	return fmt.Sprintf("%v : %v and %s at (%d, %d) on tick %d", ErrHazardCollision, e.Rover, e.Hazard, e.Coordinate.X,
+		e.Coordinate.Y, e.Tick)
+}
+
+func (e *CollisionError) Unwrap() error {
+	return ErrHazardCollision
+}
+
+//AddHazard sets the Hazard drifting over the Plateau.
+func (p *Plateau) AddHazard(h Hazard) {
+	p.Hazards = append(p.Hazards, h)
+}
+
+//HazardAt returns the first Hazard at the Coordinate on the Plateau's current Tick.
+func (p *Plateau) HazardAt(c Coordinate) (Hazard, bool) {
+	if p == nil {
+		return Hazard{}, false
+	}
+
+	for _, h := range p.Hazards {
+		if h.At(p.Tick) == c {
+			return h, true
+		}
+	}
+
+	return Hazard{}, false
+}
+
+//validHazards returns the error of the first Hazard of the Plateau which is not valid.
+func (p *Plateau) validHazards() error {
+	if p == nil {
+		return nil
+	}
+
+	for _, h := range p.Hazards {
+		if err := h.Valid(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//hazards returns the Coordinate of every Hazard on the Plateaus of the Rovers on the Plateau's current Tick, by name,
+//or nil if there are none.
+func (rs Rovers) hazards() map[string]Coordinate {
+	var positions map[string]Coordinate
+	for _, r := range rs {
+		if r.Plateau == nil {
+			continue
+		}
+
+		for _, h := range r.Plateau.Hazards {
+			if positions == nil {
+				positions = make(map[string]Coordinate)
+			}
+			positions[h.Name] = h.At(r.Plateau.Tick)
+		}
+	}
+
+	return positions
+}
+
+//collide returns a *CollisionError for the first Rover of the Mission sharing its Coordinate with a Hazard on the
+//given tick.
+func (m *Mission) collide(tick int) error {
+	for _, r := range m.Rovers {
+		if h, hit := r.Plateau.HazardAt(r.Position.Coordinate); hit {
+			return &CollisionError{Rover: r, Hazard: h.Name, Coordinate: r.Position.Coordinate, Tick: tick}
+		}
+	}
+
+	return nil
+}
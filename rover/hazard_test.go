@@ -0,0 +1,115 @@
+package rover
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHazard_At(t *testing.T) {
+	path := []Coordinate{{0, 0}, {1, 0}, {2, 0}}
+
+	tests := map[string]struct {
+		hazard Hazard
+		tick   int
+		exp    Coordinate
+	}{
+		"before the first tick at the start": {hazard: Hazard{Path: path}, tick: 0, exp: Coordinate{0, 0}},
+		"one position per tick":              {hazard: Hazard{Path: path}, tick: 2, exp: Coordinate{1, 0}},
+		"stays at the end of its path":       {hazard: Hazard{Path: path}, tick: 5, exp: Coordinate{2, 0}},
+		"repeats its path":                   {hazard: Hazard{Path: path, Repeat: true}, tick: 5, exp: Coordinate{1, 0}},
+	}
+
+	for desc, test := range tests {
+		got := test.hazard.At(test.tick)
+		assert.Equalf(t, test.exp, got, "%s failed, expected %v but got %v", desc, test.exp, got)
+	}
+}
+
+func TestMission_RunHazards(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		hazard      Hazard
+		expErr      error
+		expMovement bool
+		expTicks    int
+		expPosition Position
+	}{
+		"rover steps out of the way": {
+			rover:       &Rover{Commands: "RMLL", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			hazard:      Hazard{Name: "dust", Path: []Coordinate{{0, 2}, {0, 1}, {0, 0}}},
+			expTicks:    4,
+			expPosition: Position{Coordinate{1, 0}, West},
+		},
+		"err rover moves onto a hazard": {
+			rover:       &Rover{Commands: "RLM", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			hazard:      Hazard{Name: "dust", Path: []Coordinate{{2, 2}, {1, 2}, {0, 1}}},
+			expErr:      &CollisionError{Hazard: "dust", Coordinate: Coordinate{0, 1}, Tick: 3},
+			expMovement: true,
+			expTicks:    3,
+			expPosition: Position{Coordinate{0, 0}, North},
+		},
+		"err hazard runs into a rover standing still": {
+			rover:       &Rover{Commands: "LLLL", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			hazard:      Hazard{Name: "dust", Path: []Coordinate{{2, 1}, {1, 1}, {1, 0}, {0, 0}}, Repeat: true},
+			expErr:      &CollisionError{Hazard: "dust", Coordinate: Coordinate{0, 0}, Tick: 4},
+			expTicks:    4,
+			expPosition: Position{Coordinate{0, 0}, North},
+		},
+	}
+
+	for desc, test := range tests {
+		for _, mode := range []Mode{Sequential, Lockstep} {
+			r := *test.rover
+			start := *test.rover.Position
+			r.Position = &start
+			r.Plateau = &Plateau{Hazards: []Hazard{test.hazard}}
+			mission := &Mission{Mode: mode, Rovers: Rovers{&r}}
+
+			trace, err := mission.Run()
+			if collision, ok := test.expErr.(*CollisionError); ok {
+				if !test.expMovement {
+					collision.Rover = &r
+				}
+
+				var movement *MovementError
+				assert.Equalf(t, test.expMovement, errors.As(err, &movement), "%s in mode %d failed, expected a movement error %t but got %v", desc, mode, test.expMovement, err)
+
+				var got *CollisionError
+				if assert.Truef(t, errors.As(err, &got), "%s in mode %d failed, expected a collision but got %v", desc, mode, err) {
+					assert.Equalf(t, collision, got, "%s in mode %d failed, expected %v but got %v", desc, mode, collision, got)
+				}
+			} else {
+				assert.Nilf(t, err, "%s in mode %d failed, expected no error but got %v", desc, mode, err)
+			}
+
+			assert.Equalf(t, test.expPosition, *r.Position, "%s in mode %d failed, expected position %v but got %v", desc, mode, test.expPosition, *r.Position)
+			if assert.Lenf(t, trace.Ticks, test.expTicks, "%s in mode %d failed, expected %d ticks", desc, mode, test.expTicks) {
+				for _, tick := range trace.Ticks {
+					exp := map[string]Coordinate{"dust": test.hazard.At(tick.Number)}
+					assert.Equalf(t, exp, tick.Hazards, "%s in mode %d failed, expected hazards %v on tick %d but got %v", desc, mode, exp, tick.Number, tick.Hazards)
+				}
+			}
+		}
+	}
+}
+
+func TestRover_ValidHazard(t *testing.T) {
+	r := &Rover{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+		Plateau: &Plateau{Hazards: []Hazard{{Name: "dust"}}}}
+	assert.Truef(t, errors.Is(r.Valid(), ErrInvalidHazard), "expected a hazard without a path to be invalid")
+
+	r.Plateau.Hazards[0].Path = []Coordinate{{0, 0}}
+	assert.Truef(t, errors.Is(r.Valid(), ErrHazardCollision), "expected a rover starting on a hazard to be invalid")
+}
+
+func TestRovers_RenderHazards(t *testing.T) {
+	rovers := Rovers{{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 1},
+		Plateau: &Plateau{Tick: 2, Hazards: []Hazard{{Name: "dust", Path: []Coordinate{{0, 1}, {1, 1}}}}}}}
+
+	exp := ".*.\n" +
+		"^..\n"
+	rendered := rovers.Render()
+	assert.Equalf(t, exp, rendered, "expected\n%s\nbut got\n%s", exp, rendered)
+}
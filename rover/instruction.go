@@ -1,25 +1,118 @@
 package rover
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnknownInstruction = errors.New("rover provided unknown Instruction")
+	ErrInvalidWait        = errors.New("wait duration must be between 1 and 65536 ticks")
+)
 
-//Instruction represents the available movements a Rover can perform.
+//maxWait is the longest a single wait command may last, in ticks.
+const maxWait = 1 << 16
+
+//Instruction represents the available movements and payload actions a Rover can perform, each taking a single tick.
 type Instruction int32
 
 const (
-	Move      Instruction = 'M'
-	TurnLeft  Instruction = 'L'
-	TurnRight Instruction = 'R'
+	Move       Instruction = 'M'
+	TurnLeft   Instruction = 'L'
+	TurnRight  Instruction = 'R'
+	Wait       Instruction = 'W'
+	TakeSample Instruction = 'S'
+	Photograph Instruction = 'P'
 )
 
-//Valid will return an error if the current Instruction is not one of the three available Rover Instructions.
+//Valid will return an error if the current Instruction is not one of the available Rover Instructions.
 func (i Instruction) Valid() error {
 	switch i {
 	case Move:
 	case TurnLeft:
 	case TurnRight:
+	case Wait:
+	case TakeSample:
+	case Photograph:
 	default:
-		return fmt.Errorf("rover provided unknown Instruction{%d}", i)
+		return fmt.Errorf("%w{%d}", ErrUnknownInstruction, i)
 	}
 
 	return nil
 }
+
+//DecodeCommands turns a command string into the Instructions it describes, one per tick. Each rune is an Instruction,
+//except that a Wait may be followed by a duration in ticks, so "W3" decodes to three Waits.
+//If the commands are not valid, the Instructions decoded before the problem are returned alongside an
+//*InvalidInstructionError, whose Step is the index of the Instruction that could not be decoded.
+func DecodeCommands(commands string) ([]Instruction, error) {
+	runes := []rune(commands)
+	instructions := make([]Instruction, 0, len(runes))
+
+	for i := 0; i < len(runes); i++ {
+		instruction := Instruction(runes[i])
+		if err := instruction.Valid(); err != nil {
+			return instructions, &InvalidInstructionError{
+				Step:        len(instructions),
+				Instruction: instruction,
+				Err:         err,
+			}
+		}
+
+		if instruction != Wait {
+			instructions = append(instructions, instruction)
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && runes[end] >= '0' && runes[end] <= '9' {
+			end++
+		}
+
+		duration := 1
+		if end > i+1 {
+			var err error
+			duration, err = strconv.Atoi(string(runes[i+1 : end]))
+			if err != nil || duration < 1 || duration > maxWait {
+				return instructions, &InvalidInstructionError{
+					Step:        len(instructions),
+					Instruction: instruction,
+					Err:         fmt.Errorf("%w : %s", ErrInvalidWait, string(runes[i:end])),
+				}
+			}
+		}
+
+		for t := 0; t < duration; t++ {
+			instructions = append(instructions, Wait)
+		}
+		i = end - 1
+	}
+
+	return instructions, nil
+}
+
+//EncodeCommands turns Instructions into a command string, writing consecutive Waits as a single Wait with a duration.
+func EncodeCommands(instructions []Instruction) string {
+	var commands strings.Builder
+
+	for i := 0; i < len(instructions); i++ {
+		commands.WriteRune(rune(instructions[i]))
+		if instructions[i] != Wait {
+			continue
+		}
+
+		duration := 1
+		for i+duration < len(instructions) && instructions[i+duration] == Wait && duration < maxWait {
+			duration++
+		}
+
+		if duration > 1 {
+			commands.WriteString(strconv.Itoa(duration))
+		}
+		i += duration - 1
+	}
+
+	return commands.String()
+}
@@ -0,0 +1,86 @@
+package rover
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeCommands(t *testing.T) {
+	tests := map[string]struct {
+		commands        string
+		expInstructions []Instruction
+		expErr          error
+	}{
+		"one instruction per rune": {
+			commands:        "LMRW",
+			expInstructions: []Instruction{TurnLeft, Move, TurnRight, Wait},
+		},
+		"wait with a duration": {
+			commands:        "MW3M",
+			expInstructions: []Instruction{Move, Wait, Wait, Wait, Move},
+		},
+		"wait with a multi digit duration at the end": {
+			commands:        "W12",
+			expInstructions: []Instruction{Wait, Wait, Wait, Wait, Wait, Wait, Wait, Wait, Wait, Wait, Wait, Wait},
+		},
+		"err duration without a wait": {
+			commands:        "MW2M3",
+			expInstructions: []Instruction{Move, Wait, Wait, Move},
+			expErr: &InvalidInstructionError{
+				Step:        4,
+				Instruction: '3',
+				Err:         fmt.Errorf("%w{%d}", ErrUnknownInstruction, '3'),
+			},
+		},
+		"err wait of zero ticks": {
+			commands:        "MW0",
+			expInstructions: []Instruction{Move},
+			expErr: &InvalidInstructionError{
+				Step:        1,
+				Instruction: Wait,
+				Err:         fmt.Errorf("%w : %s", ErrInvalidWait, "W0"),
+			},
+		},
+		"err wait too long": {
+			commands:        "W99999999",
+			expInstructions: []Instruction{},
+			expErr: &InvalidInstructionError{
+				Instruction: Wait,
+				Err:         fmt.Errorf("%w : %s", ErrInvalidWait, "W99999999"),
+			},
+		},
+	}
+
+	for desc, test := range tests {
+		instructions, err := DecodeCommands(test.commands)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expInstructions, instructions, "%s failed, expected %v but got %v", desc, test.expInstructions, instructions)
+	}
+}
+
+func TestEncodeCommands(t *testing.T) {
+	tests := map[string]struct {
+		instructions []Instruction
+		expCommands  string
+	}{
+		"single wait has no duration": {
+			instructions: []Instruction{Move, Wait, Move},
+			expCommands:  "MWM",
+		},
+		"consecutive waits have a duration": {
+			instructions: []Instruction{Wait, Wait, TurnLeft, Wait, Wait, Wait},
+			expCommands:  "W2LW3",
+		},
+	}
+
+	for desc, test := range tests {
+		commands := EncodeCommands(test.instructions)
+		assert.Equalf(t, test.expCommands, commands, "%s failed, expected %s but got %s", desc, test.expCommands, commands)
+
+		instructions, err := DecodeCommands(commands)
+		assert.Nilf(t, err, "%s failed, expected commands to decode", desc)
+		assert.Equalf(t, test.instructions, instructions, "%s failed, expected round trip %v but got %v", desc, test.instructions, instructions)
+	}
+}
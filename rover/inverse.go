@@ -0,0 +1,235 @@
+package rover
+
+import (
+	"fmt"
+	"strings"
+)
+
+//maxInferred limits how many command strings Infer returns by default, as the number of equally short command
+//strings grows very quickly with distance.
+const maxInferred = 1000
+
+//InferOptions constrains the command strings returned by Infer.
+type InferOptions struct {
+	//MaxLength is the most instructions a command string may have, zero means no limit.
+	MaxLength int
+	//Waypoints are Coordinates the Rover is known to have passed through, in order.
+	Waypoints []Coordinate
+	//Limit is the most command strings to return, zero means a limit of 1000.
+	Limit int
+}
+
+//Infer returns every shortest command string which takes the Rover from its Position to the end Position, passing
+//through any waypoints in order, within the Rover's boundary and away from obstacles. Commands are returned in a
+//stable order, up to the Limit. The Rover itself is not moved.
+//If the end cannot be reached, or cannot be reached within MaxLength, an *UnreachableError is returned.
+func Infer(r *Rover, end Position, options InferOptions) ([]string, error) {
+	if err := r.validPlacement(); err != nil {
+		return nil, err
+	}
+
+	limit := options.Limit
+	if limit <= 0 {
+		limit = maxInferred
+	}
+
+	type state struct {
+		Position
+		waypoint int
+	}
+
+	//reached moves a state on past every waypoint at its Coordinate
+	reached := func(s state) state {
+		for s.waypoint < len(options.Waypoints) && options.Waypoints[s.waypoint] == s.Coordinate {
+			s.waypoint++
+		}
+		return s
+	}
+
+	type edge struct {
+		from        state
+		instruction Instruction
+	}
+
+	start := reached(state{Position: *r.Position})
+	goal := state{Position: end, waypoint: len(options.Waypoints)}
+	depth := map[state]int{start: 0}
+	previous := make(map[state][]edge)
+
+	//search breadth first, layer by layer, remembering every way of reaching each state in the fewest instructions
+	for layer := []state{start}; len(layer) > 0; {
+		if _, found := depth[goal]; found {
+			break
+		}
+
+		var next []state
+		for _, current := range layer {
+			for _, instruction := range planInstructions {
+				position, err := r.after(current.Position, instruction)
+				if err != nil {
+					continue
+				}
+
+				s := reached(state{Position: position, waypoint: current.waypoint})
+				d, seen := depth[s]
+				switch {
+				case !seen:
+					depth[s] = depth[current] + 1
+					next = append(next, s)
+				case d != depth[current]+1:
+					continue
+				}
+				previous[s] = append(previous[s], edge{from: current, instruction: instruction})
+			}
+		}
+		layer = next
+	}
+
+	length, found := depth[goal]
+	if !found || (options.MaxLength > 0 && length > options.MaxLength) {
+		err := ErrTargetUnreachable
+		if found {
+			err = fmt.Errorf("%w : shortest is %d commands, more than %d", ErrTargetUnreachable, length, options.MaxLength)
+		}
+
+		return nil, &UnreachableError{
+			Rover:  r,
+			From:   *r.Position,
+			Target: end.Coordinate,
+			Facing: end.Direction,
+			Err:    err,
+		}
+	}
+
+	//walk back from the goal, building every command string in reverse
+	var commands []string
+	var walk func(s state, suffix []rune)
+	walk = func(s state, suffix []rune) {
+		if len(commands) >= limit {
+			return
+		}
+
+		if s == start {
+			commands = append(commands, string(suffix))
+			return
+		}
+
+		for _, e := range previous[s] {
+			walk(e.from, append([]rune{rune(e.instruction)}, suffix...))
+		}
+	}
+	walk(goal, nil)
+
+	return commands, nil
+}
+
+//PoseDiff explains how a recorded Position differs from the Position predicted by executing commands.
+type PoseDiff struct {
+	Predicted Position
+	Recorded  Position
+	//East and North are how far the recorded Coordinate is from the predicted one.
+	East, North int
+	//Turns is the number of turns to the right from the predicted Direction to the recorded one, between -1 and 2 on a
+	//Square Grid or -2 and 3 on a Hex Grid, where -1 is a single turn to the left.
+	Turns int
+	//Err is any error executing the commands, in which case Predicted is where the Rover would have stopped.
+	Err error
+	//Explanations describe single mistakes in executing the commands which would lead to the recorded Position.
+	Explanations []string
+}
+
+//Matches reports whether the recorded Position is the predicted one.
+func (d *PoseDiff) Matches() bool {
+	return d.Predicted == d.Recorded
+}
+
+func (d *PoseDiff) String() string {
+	if d.Matches() {
+		return fmt.Sprintf("recorded (%d, %d) matches prediction", d.Recorded.X, d.Recorded.Y)
+	}
+
+	diff := fmt.Sprintf("predicted (%d, %d) but recorded (%d, %d), %d east %d north and %d turns right",
+		d.Predicted.X, d.Predicted.Y, d.Recorded.X, d.Recorded.Y, d.East, d.North, d.Turns)
+	if len(d.Explanations) > 0 {
+		diff += " : " + strings.Join(d.Explanations, ", or ")
+	}
+
+	return diff
+}
+
+//Diff predicts where the Rover ends up executing the commands from its Position and explains how the recorded
+//Position differs from that prediction, including which single mistake executing the commands would explain it.
+//The Rover itself is not moved.
+func Diff(r *Rover, commands string, recorded Position) (*PoseDiff, error) {
+	if err := r.validPlacement(); err != nil {
+		return nil, err
+	}
+
+	instructions, err := r.decode(commands)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &PoseDiff{Recorded: recorded}
+	diff.Predicted, diff.Err = r.predict(instructions)
+	diff.East = recorded.X - diff.Predicted.X
+	diff.North = recorded.Y - diff.Predicted.Y
+	headings := len(r.Grid.Headings())
+	diff.Turns = (r.Grid.turns(recorded.Direction) - r.Grid.turns(diff.Predicted.Direction) + headings) % headings
+	if diff.Turns > headings/2 {
+		diff.Turns -= headings
+	}
+
+	if diff.Matches() {
+		return diff, nil
+	}
+
+	explains := func(variant []Instruction) bool {
+		end, err := r.predict(variant)
+		return err == nil && end == recorded
+	}
+
+	for i, instruction := range instructions {
+		variant := append(append([]Instruction{}, instructions[:i]...), instructions[i+1:]...)
+		if explains(variant) {
+			diff.Explanations = append(diff.Explanations, fmt.Sprintf("command %d %q was not executed", i, rune(instruction)))
+		}
+
+		for _, replacement := range planInstructions {
+			if replacement == instruction {
+				continue
+			}
+
+			variant := append([]Instruction{}, instructions...)
+			variant[i] = replacement
+			if explains(variant) {
+				diff.Explanations = append(diff.Explanations, fmt.Sprintf("command %d %q was executed as %q", i, rune(instruction), rune(replacement)))
+			}
+		}
+	}
+
+	for i := 0; i <= len(instructions); i++ {
+		for _, extra := range planInstructions {
+			variant := append(append(append([]Instruction{}, instructions[:i]...), extra), instructions[i:]...)
+			if explains(variant) {
+				diff.Explanations = append(diff.Explanations, fmt.Sprintf("an extra %q was executed before command %d", rune(extra), i))
+			}
+		}
+	}
+
+	return diff, nil
+}
+
+//predict returns where the Rover ends up executing the Instructions from its Position, without moving the Rover.
+func (r *Rover) predict(instructions []Instruction) (Position, error) {
+	position := *r.Position
+	for _, instruction := range instructions {
+		next, err := r.after(position, instruction)
+		if err != nil {
+			return position, err
+		}
+		position = next
+	}
+
+	return position, nil
+}
@@ -0,0 +1,128 @@
+package rover
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfer(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		end         Position
+		options     InferOptions
+		expCommands []string
+		expErr      error
+	}{
+		"same position needs no commands": {
+			rover:       &Rover{Position: &Position{Coordinate{1, 1}, North}, Boundary: &Coordinate{2, 2}},
+			end:         Position{Coordinate{1, 1}, North},
+			expCommands: []string{""},
+		},
+		"every shortest way of turning around": {
+			rover:       &Rover{Position: &Position{Coordinate{1, 1}, North}, Boundary: &Coordinate{2, 2}},
+			end:         Position{Coordinate{1, 1}, South},
+			expCommands: []string{"LL", "RR"},
+		},
+		"every shortest way to a diagonal": {
+			rover:       &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			end:         Position{Coordinate{1, 1}, North},
+			expCommands: []string{"MRML", "RMLM"},
+		},
+		"waypoints narrow the commands": {
+			rover:       &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			end:         Position{Coordinate{1, 1}, North},
+			options:     InferOptions{Waypoints: []Coordinate{{1, 0}}},
+			expCommands: []string{"RMLM"},
+		},
+		"limit caps the number of commands": {
+			rover:       &Rover{Position: &Position{Coordinate{1, 1}, North}, Boundary: &Coordinate{2, 2}},
+			end:         Position{Coordinate{1, 1}, South},
+			options:     InferOptions{Limit: 1},
+			expCommands: []string{"LL"},
+		},
+		"err shortest commands longer than max length": {
+			rover:   &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			end:     Position{Coordinate{1, 1}, North},
+			options: InferOptions{MaxLength: 3},
+			expErr: &UnreachableError{
+				From:   Position{Coordinate{0, 0}, North},
+				Target: Coordinate{1, 1},
+				Facing: North,
+				Err:    fmt.Errorf("%w : shortest is %d commands, more than %d", ErrTargetUnreachable, 4, 3),
+			},
+		},
+		"err end outside boundary": {
+			rover: &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			end:   Position{Coordinate{3, 3}, North},
+			expErr: &UnreachableError{
+				From:   Position{Coordinate{0, 0}, North},
+				Target: Coordinate{3, 3},
+				Facing: North,
+				Err:    ErrTargetUnreachable,
+			},
+		},
+	}
+
+	for desc, test := range tests {
+		commands, err := Infer(test.rover, test.end, test.options)
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.ElementsMatchf(t, test.expCommands, commands, "%s failed, expected %v but got %v", desc, test.expCommands, commands)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := map[string]struct {
+		rover           *Rover
+		commands        string
+		recorded        Position
+		expDiff         *PoseDiff
+		expExplanations []string
+	}{
+		"recorded position matches prediction": {
+			rover:    &Rover{Position: &Position{Coordinate{1, 2}, North}, Boundary: &Coordinate{5, 5}},
+			commands: "LMLMLMLMM",
+			recorded: Position{Coordinate{1, 3}, North},
+			expDiff: &PoseDiff{
+				Predicted: Position{Coordinate{1, 3}, North},
+				Recorded:  Position{Coordinate{1, 3}, North},
+			},
+		},
+		"recorded position one move short": {
+			rover:    &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{5, 5}},
+			commands: "MMRM",
+			recorded: Position{Coordinate{1, 1}, East},
+			expDiff: &PoseDiff{
+				Predicted: Position{Coordinate{1, 2}, East},
+				Recorded:  Position{Coordinate{1, 1}, East},
+				North:     -1,
+				Explanations: []string{
+					"command 0 'M' was not executed",
+					"command 1 'M' was not executed",
+				},
+			},
+		},
+		"recorded direction turned the wrong way": {
+			rover:    &Rover{Position: &Position{Coordinate{1, 1}, North}, Boundary: &Coordinate{2, 2}},
+			commands: "RM",
+			recorded: Position{Coordinate{0, 1}, West},
+			expDiff: &PoseDiff{
+				Predicted: Position{Coordinate{2, 1}, East},
+				Recorded:  Position{Coordinate{0, 1}, West},
+				East:      -2,
+				Turns:     2,
+				Explanations: []string{
+					"command 0 'R' was executed as 'L'",
+				},
+			},
+		},
+	}
+
+	for desc, test := range tests {
+		diff, err := Diff(test.rover, test.commands, test.recorded)
+		assert.Nilf(t, err, "%s failed, expected nil error but got %v", desc, err)
+		assert.Equalf(t, test.expDiff, diff, "%s failed, expected %v but got %v", desc, test.expDiff, diff)
+	}
+}
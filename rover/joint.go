@@ -0,0 +1,428 @@
+package rover
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrNoJointPlan     = errors.New("no collision free plan found")
+	ErrGoalsMismatched = errors.New("exactly one goal is required for each rover")
+	ErrGoalsShared     = errors.New("rovers must not share a goal")
+)
+
+//maxJointExpansions limits how many sets of constraints the joint planner tries before giving up. Callers wanting to
+//give up sooner than that can use PlanJointContext.
+const maxJointExpansions = 1000
+
+//jointInstructions are the instructions the joint planner may use, waiting lets a Rover give way to another.
+var jointInstructions = []Instruction{Move, TurnLeft, TurnRight, Wait}
+
+//PlanJoint returns one command string per Rover which takes each Rover from its Position to its goal Coordinate, such
+//that when run together in a Lockstep Mission no two Rovers are ever on the same Coordinate and no two Rovers swap
+//Coordinates. Rovers give way to each other using Wait instructions. Finished Rovers stay on their goals, so the plans
+//never pass through the goal of a Rover which has already arrived. A Rover which starts on its goal and need not move
+//out of the way is given a single Wait, as a Mission requires commands for every Rover. The Rovers are not moved.
+//Plans are found with conflict based search, so the total number of instructions is as small as possible. If there is
+//no such plan, or one cannot be found quickly, an error wrapping ErrNoJointPlan is returned.
+func PlanJoint(rovers Rovers, goals []Coordinate) ([]string, error) {
+	return PlanJointContext(context.Background(), rovers, goals)
+}
+
+//PlanJointContext behaves like PlanJoint but checks ctx before trying each set of constraints, returning the context's
+//error if ctx is cancelled before a plan is found.
+func PlanJointContext(ctx context.Context, rovers Rovers, goals []Coordinate) ([]string, error) {
+	if len(rovers) != len(goals) {
+		return nil, ErrGoalsMismatched
+	}
+
+	starts := make(map[Coordinate]bool, len(rovers))
+	ends := make(map[Coordinate]bool, len(goals))
+	for i, r := range rovers {
+		if err := r.validPlacement(); err != nil {
+			return nil, err
+		}
+
+		if starts[r.Believed().Coordinate] {
+			return nil, ErrRoversShareCoordinate
+		}
+		starts[r.Believed().Coordinate] = true
+
+		if ends[goals[i]] {
+			return nil, ErrGoalsShared
+		}
+		ends[goals[i]] = true
+	}
+
+	if err := rovers.unpassable(goals); err != nil {
+		return nil, err
+	}
+
+	root := &jointNode{
+		commands: make([]string, len(rovers)),
+		paths:    make([][]Coordinate, len(rovers)),
+		sweeps:   make([][][]Coordinate, len(rovers)),
+	}
+	for i, r := range rovers {
+		commands, path, sweeps, found := r.searchTimed(i, goals[i], nil)
+		if !found {
+			return nil, &UnreachableError{Rover: r, From: r.Believed(), Target: goals[i], Err: fmt.Errorf("%w : %v", ErrNoJointPlan, ErrTargetUnreachable)}
+		}
+		root.commands[i], root.paths[i], root.sweeps[i] = commands, path, sweeps
+		root.cost += len(path) - 1
+	}
+
+	open := &jointQueue{root}
+	for expansions := 0; open.Len() > 0 && expansions < maxJointExpansions; expansions++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		node := heap.Pop(open).(*jointNode)
+
+		conflict, found := node.conflict()
+		if !found {
+			for i, commands := range node.commands {
+				if commands == "" {
+					node.commands[i] = string(Wait)
+				}
+			}
+
+			return node.commands, nil
+		}
+
+		for _, c := range conflict {
+			i := c.rover
+			child := &jointNode{
+				constraints: append(append([]constraint{}, node.constraints...), c),
+				commands:    append([]string{}, node.commands...),
+				paths:       append([][]Coordinate{}, node.paths...),
+				sweeps:      append([][][]Coordinate{}, node.sweeps...),
+			}
+
+			commands, path, sweeps, found := rovers[i].searchTimed(i, goals[i], child.constraints)
+			if !found {
+				continue
+			}
+			child.commands[i], child.paths[i], child.sweeps[i] = commands, path, sweeps
+			child.cost = node.cost - len(node.paths[i]) + len(path)
+
+			heap.Push(open, child)
+		}
+	}
+
+	return nil, ErrNoJointPlan
+}
+
+//unpassable returns an error wrapping ErrNoJointPlan if two Rovers confined to the same corridor must pass each other
+//in it to reach their goals, which no amount of giving way allows. It saves searching every set of constraints for a
+//plan which cannot exist.
+func (rs Rovers) unpassable(goals []Coordinate) error {
+	corridors := make([][]Coordinate, len(rs))
+	for i, r := range rs {
+		corridors[i] = r.corridor()
+	}
+
+	for i, corridor := range corridors {
+		order := make(map[Coordinate]int, len(corridor))
+		for n, c := range corridor {
+			order[c] = n
+		}
+
+		for j := i + 1; j < len(rs); j++ {
+			if len(corridors[j]) != len(corridor) {
+				continue
+			}
+
+			same := true
+			for _, c := range corridors[j] {
+				_, on := order[c]
+				same = same && on
+			}
+			if !same {
+				continue
+			}
+
+			//a goal off the corridor is unreachable, which searching for the Rover's own plan reports
+			goalI, onI := order[goals[i]]
+			goalJ, onJ := order[goals[j]]
+			if onI && onJ && (order[rs[i].Believed().Coordinate] < order[rs[j].Believed().Coordinate]) != (goalI < goalJ) {
+				return fmt.Errorf("%w : rovers %d and %d cannot pass each other in the corridor from (%d, %d) to (%d, %d)",
+					ErrNoJointPlan, i, j, corridor[0].X, corridor[0].Y, corridor[len(corridor)-1].X, corridor[len(corridor)-1].Y)
+			}
+		}
+	}
+
+	return nil
+}
+
+//corridor returns every Coordinate the Rover can reach in order from one end to the other, when they form a line no
+//wider than a single cell, and nil otherwise. Every Move between them passes along the line, so two Rovers confined to
+//it can never pass each other.
+func (r *Rover) corridor() []Coordinate {
+	start := r.Believed().Coordinate
+	links := map[Coordinate]map[Coordinate]bool{start: {}}
+	link := func(a, b Coordinate) bool {
+		for _, pair := range [][2]Coordinate{{a, b}, {b, a}} {
+			if links[pair[0]] == nil {
+				links[pair[0]] = make(map[Coordinate]bool)
+			}
+			links[pair[0]][pair[1]] = true
+		}
+
+		return len(links[a]) <= 2 && len(links[b]) <= 2
+	}
+
+	for queue := []Coordinate{start}; len(queue) > 0; queue = queue[1:] {
+		for _, d := range r.Grid.Headings() {
+			from := Position{Coordinate: queue[0], Direction: d}
+			to, err := r.after(from, Move)
+			if err != nil || to.Coordinate == from.Coordinate {
+				continue
+			}
+
+			if _, seen := links[to.Coordinate]; !seen {
+				queue = append(queue, to.Coordinate)
+			}
+
+			previous := from.Coordinate
+			for _, c := range r.sweep(from, Move, to) {
+				if c != previous && !link(previous, c) {
+					return nil
+				}
+				previous = c
+			}
+		}
+	}
+
+	//a line is the only connected shape with every cell linked to at most two others and one fewer links than cells,
+	//it is walked from the end nearest the origin so the order is the same every time
+	var end *Coordinate
+	count := 0
+	for c, linked := range links {
+		c := c
+		count += len(linked)
+		if len(linked) < 2 && (end == nil || c.Y < end.Y || c.Y == end.Y && c.X < end.X) {
+			end = &c
+		}
+	}
+	if count/2 != len(links)-1 {
+		return nil
+	}
+
+	line := []Coordinate{*end}
+	for previous, at := *end, *end; len(line) < len(links); {
+		for c := range links[at] {
+			if c != previous {
+				previous, at = at, c
+				break
+			}
+		}
+		line = append(line, at)
+	}
+
+	return line
+}
+
+//constraint forbids a Rover from being on a Coordinate at a tick, or when edge is set from moving from one Coordinate
+//onto another arriving at that tick.
+type constraint struct {
+	rover int
+	at    Coordinate
+	from  Coordinate
+	tick  int
+	edge  bool
+}
+
+//jointNode is a set of constraints along with the cheapest plans for each Rover which respect them. Paths hold the
+//Coordinate of each Rover at every tick, and sweeps every Coordinate each Rover passes through during every tick.
+type jointNode struct {
+	constraints []constraint
+	commands    []string
+	paths       [][]Coordinate
+	sweeps      [][][]Coordinate
+	cost        int
+}
+
+//at returns the Coordinate of the Rover at the given tick, Rovers stay where they finish.
+func (n *jointNode) at(rover, tick int) Coordinate {
+	path := n.paths[rover]
+	if tick >= len(path) {
+		return path[len(path)-1]
+	}
+
+	return path[tick]
+}
+
+//swept returns every Coordinate the Rover passes through during the given tick, Rovers stay where they finish.
+func (n *jointNode) swept(rover, tick int) []Coordinate {
+	sweeps := n.sweeps[rover]
+	if tick >= len(sweeps) {
+		return []Coordinate{n.at(rover, tick)}
+	}
+
+	return sweeps[tick]
+}
+
+//conflict returns the first collision between two Rovers' plans, as the pair of constraints which would each avoid it.
+func (n *jointNode) conflict() ([]constraint, bool) {
+	ticks := 0
+	for _, path := range n.paths {
+		if len(path) > ticks {
+			ticks = len(path)
+		}
+	}
+
+	for tick := 1; tick < ticks; tick++ {
+		for i := range n.paths {
+			for j := i + 1; j < len(n.paths); j++ {
+				for _, c := range n.swept(i, tick) {
+					if passes(n.swept(j, tick), c) {
+						return []constraint{
+							{rover: i, at: c, tick: tick},
+							{rover: j, at: c, tick: tick},
+						}, true
+					}
+				}
+
+				if passes(n.swept(i, tick), n.at(j, tick-1)) && passes(n.swept(j, tick), n.at(i, tick-1)) {
+					return []constraint{
+						{rover: i, from: n.at(i, tick-1), at: n.at(i, tick), tick: tick, edge: true},
+						{rover: j, from: n.at(j, tick-1), at: n.at(j, tick), tick: tick, edge: true},
+					}, true
+				}
+			}
+		}
+	}
+
+	return nil, false
+}
+
+//searchTimed finds the fewest instructions taking the Rover to the goal while respecting the constraints placed on the
+//Rover at the given index, returning the commands, the Coordinate of the Rover at every tick and every Coordinate it
+//passes through during every tick. A Rover passes through a vertex constraint if it is anywhere on its sweep.
+func (r *Rover) searchTimed(index int, goal Coordinate, constraints []constraint) (string, []Coordinate, [][]Coordinate, bool) {
+	type vertex struct {
+		at   Coordinate
+		tick int
+	}
+	type edge struct {
+		from, at Coordinate
+		tick     int
+	}
+
+	vertices := make(map[vertex]bool)
+	edges := make(map[edge]bool)
+	lastTick, lastGoalTick := 0, -1
+	for _, c := range constraints {
+		if c.rover != index {
+			continue
+		}
+
+		if c.edge {
+			edges[edge{c.from, c.at, c.tick}] = true
+		} else {
+			vertices[vertex{c.at, c.tick}] = true
+			if c.at == goal && c.tick > lastGoalTick {
+				lastGoalTick = c.tick
+			}
+		}
+
+		if c.tick > lastTick {
+			lastTick = c.tick
+		}
+	}
+
+	type state struct {
+		Position
+		tick int
+	}
+	type step struct {
+		from        state
+		instruction Instruction
+		sweep       []Coordinate
+	}
+
+	//beyond the last constraint every tick is alike, so states are only distinguished up to one tick after it
+	key := func(s state) state {
+		if s.tick > lastTick {
+			s.tick = lastTick + 1
+		}
+		return s
+	}
+
+	start := state{Position: r.Believed()}
+	visited := map[state]step{key(start): {}}
+	for queue := []state{start}; len(queue) > 0; queue = queue[1:] {
+		current := queue[0]
+		if current.Coordinate == goal && current.tick > lastGoalTick {
+			instructions := make([]Instruction, current.tick)
+			path := make([]Coordinate, current.tick+1)
+			sweeps := make([][]Coordinate, current.tick+1)
+			for s := current; s.tick > 0; s = visited[key(s)].from {
+				instructions[s.tick-1] = visited[key(s)].instruction
+				path[s.tick] = s.Coordinate
+				sweeps[s.tick] = visited[key(s)].sweep
+			}
+			path[0] = start.Coordinate
+			sweeps[0] = []Coordinate{start.Coordinate}
+
+			return EncodeCommands(instructions), path, sweeps, true
+		}
+
+		for _, instruction := range jointInstructions {
+			position, err := r.after(current.Position, instruction)
+			if err != nil {
+				continue
+			}
+
+			next := state{Position: position, tick: current.tick + 1}
+			if edges[edge{current.Coordinate, next.Coordinate, next.tick}] {
+				continue
+			}
+
+			sweep := r.sweep(current.Position, instruction, position)
+			constrained := false
+			for _, c := range sweep {
+				constrained = constrained || vertices[vertex{c, next.tick}]
+			}
+			if constrained {
+				continue
+			}
+
+			if _, seen := visited[key(next)]; !seen {
+				visited[key(next)] = step{from: current, instruction: instruction, sweep: sweep}
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return "", nil, nil, false
+}
+
+//jointQueue is a priority queue of jointNodes, cheapest first.
+type jointQueue []*jointNode
+
+func (q jointQueue) Len() int { return len(q) }
+
+func (q jointQueue) Less(i, j int) bool {
+	if q[i].cost != q[j].cost {
+		return q[i].cost < q[j].cost
+	}
+
+	return len(q[i].constraints) < len(q[j].constraints)
+}
+
+func (q jointQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *jointQueue) Push(x interface{}) { *q = append(*q, x.(*jointNode)) }
+
+func (q *jointQueue) Pop() interface{} {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return n
+}
@@ -0,0 +1,139 @@
+package rover
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanJoint(t *testing.T) {
+	tests := map[string]struct {
+		rovers    Rovers
+		goals     []Coordinate
+		expLength int
+		expErr    error
+	}{
+		"independent rovers plan their shortest paths": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{3, 3}},
+				{Position: &Position{Coordinate{3, 0}, North}, Boundary: &Coordinate{3, 3}},
+			},
+			goals:     []Coordinate{{0, 3}, {3, 3}},
+			expLength: 6,
+		},
+		"rovers crossing paths wait for each other": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 1}, East}, Boundary: &Coordinate{2, 2}},
+				{Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 2}},
+			},
+			goals:     []Coordinate{{2, 1}, {1, 2}},
+			expLength: 5,
+		},
+		"rovers swapping ends of a corridor use a passing place": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 1}, Plateau: &Plateau{Obstacles: map[Coordinate]bool{{0, 1}: true, {2, 1}: true}}},
+				{Position: &Position{Coordinate{2, 0}, West}, Boundary: &Coordinate{2, 1}, Plateau: &Plateau{Obstacles: map[Coordinate]bool{{0, 1}: true, {2, 1}: true}}},
+			},
+			goals: []Coordinate{{2, 0}, {0, 0}},
+		},
+		"strider waits rather than drive through a rover": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 2}, Model: strider},
+				{Position: &Position{Coordinate{1, 1}, South}, Boundary: &Coordinate{2, 2}},
+			},
+			goals:     []Coordinate{{2, 0}, {1, 0}},
+			expLength: 3,
+		},
+		"rover already on its goal waits": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+				{Position: &Position{Coordinate{2, 0}, North}, Boundary: &Coordinate{2, 2}},
+			},
+			goals:     []Coordinate{{0, 0}, {2, 2}},
+			expLength: 3,
+		},
+		"err rovers swapping ends of a corridor without a passing place": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 0}},
+				{Position: &Position{Coordinate{2, 0}, West}, Boundary: &Coordinate{2, 0}},
+			},
+			goals: []Coordinate{{2, 0}, {0, 0}},
+			expErr: fmt.Errorf("%w : rovers %d and %d cannot pass each other in the corridor from (%d, %d) to (%d, %d)",
+				ErrNoJointPlan, 0, 1, 0, 0, 2, 0),
+		},
+		"err rovers swapping ends of a corridor around a corner": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1}, Plateau: &Plateau{Obstacles: map[Coordinate]bool{{1, 0}: true}}},
+				{Position: &Position{Coordinate{1, 1}, West}, Boundary: &Coordinate{1, 1}, Plateau: &Plateau{Obstacles: map[Coordinate]bool{{1, 0}: true}}},
+			},
+			goals: []Coordinate{{1, 1}, {0, 0}},
+			expErr: fmt.Errorf("%w : rovers %d and %d cannot pass each other in the corridor from (%d, %d) to (%d, %d)",
+				ErrNoJointPlan, 0, 1, 0, 0, 1, 1),
+		},
+		"err goals shared": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 0}},
+				{Position: &Position{Coordinate{2, 0}, West}, Boundary: &Coordinate{2, 0}},
+			},
+			goals:  []Coordinate{{1, 0}, {1, 0}},
+			expErr: ErrGoalsShared,
+		},
+		"err goals mismatched": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 0}},
+			},
+			goals:  []Coordinate{},
+			expErr: ErrGoalsMismatched,
+		},
+	}
+
+	for desc, test := range tests {
+		plans, err := PlanJoint(test.rovers, test.goals)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		if err != nil {
+			continue
+		}
+
+		length := 0
+		for i, r := range test.rovers {
+			r.Commands = plans[i]
+			instructions, err := DecodeCommands(plans[i])
+			assert.Nilf(t, err, "%s failed, expected plan %s to decode but got %v", desc, plans[i], err)
+			length += len(instructions)
+		}
+		if test.expLength > 0 {
+			assert.Equalf(t, test.expLength, length, "%s failed, expected %d instructions but got %d from %v", desc, test.expLength, length, plans)
+		}
+
+		trace, err := (&Mission{Mode: Lockstep, Rovers: test.rovers}).Run()
+		assert.Nilf(t, err, "%s failed, expected plans %v to run cleanly but got %v", desc, plans, err)
+		for _, tick := range trace.Ticks {
+			for _, step := range tick.Steps {
+				assert.Falsef(t, step.Yielded, "%s failed, expected plans %v not to yield on tick %d", desc, plans, tick.Number)
+			}
+		}
+		for i, r := range test.rovers {
+			assert.Equalf(t, test.goals[i], r.Position.Coordinate, "%s failed, expected rover %d to reach %v", desc, i, test.goals[i])
+		}
+	}
+}
+
+func TestPlanJointContext(t *testing.T) {
+	rovers := Rovers{
+		{Position: &Position{Coordinate{0, 1}, East}, Boundary: &Coordinate{2, 2}},
+		{Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 2}},
+	}
+	goals := []Coordinate{{2, 1}, {1, 2}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	plans, err := PlanJointContext(ctx, rovers, goals)
+	assert.Equalf(t, context.Canceled, err, "expected the planner to stop with %v but got %v", context.Canceled, err)
+	assert.Nilf(t, plans, "expected no plans but got %v", plans)
+
+	plans, err = PlanJointContext(context.Background(), rovers, goals)
+	assert.Nilf(t, err, "expected plans but got %v", err)
+	assert.Lenf(t, plans, len(rovers), "expected a plan for every rover but got %v", plans)
+}
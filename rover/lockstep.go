@@ -0,0 +1,220 @@
+package rover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrLockstepDeadlock = errors.New("rovers are blocking each other and cannot make progress")
+
+//RightOfWay decides which Rover proceeds when two Rovers in a Lockstep Mission want to enter the same Coordinate,
+//or want to swap Coordinates with each other.
+type RightOfWay uint8
+
+const (
+	//FirstDeployed gives way to the Rover that appears earliest in the Mission's Rovers.
+	FirstDeployed RightOfWay = iota
+	//LastDeployed gives way to the Rover that appears latest in the Mission's Rovers.
+	LastDeployed
+	//MostRemaining gives way to the Rover with the most instructions left to execute, ties go to FirstDeployed.
+	MostRemaining
+)
+
+//Valid will return an error if the RightOfWay is not one of the known rules.
+func (w RightOfWay) Valid() error {
+	switch w {
+	case FirstDeployed:
+	case LastDeployed:
+	case MostRemaining:
+	default:
+		return fmt.Errorf("mission provided unknown RightOfWay{%d}", w)
+	}
+
+	return nil
+}
+
+//prefers reports whether the Rover at index a has right of way over the Rover at index b.
+func (w RightOfWay) prefers(a, b int, remaining []int) bool {
+	switch w {
+	case LastDeployed:
+		return a > b
+	case MostRemaining:
+		if remaining[a] != remaining[b] {
+			return remaining[a] > remaining[b]
+		}
+	}
+
+	return a < b
+}
+
+//resolve takes every Coordinate each moving Rover passes through this tick, ending on its target, and returns the
+//Rovers which must yield. A Rover yields when it loses right of way for a Coordinate, loses right of way in a swap, or
+//when a Rover occupying any Coordinate it passes through is not leaving this tick.
+func (w RightOfWay) resolve(rovers Rovers, routes map[int][]Coordinate, remaining []int) map[int]bool {
+	occupants := make(map[Coordinate]int, len(rovers))
+	for i, r := range rovers {
+		occupants[r.Position.Coordinate] = i
+	}
+
+	blocked := make(map[int]bool)
+	for changed := true; changed; {
+		changed = false
+
+		for i := range rovers {
+			if _, moving := routes[i]; !moving || blocked[i] {
+				continue
+			}
+
+			if w.yields(i, rovers, routes, occupants, remaining, blocked) {
+				blocked[i] = true
+				changed = true
+			}
+		}
+	}
+
+	return blocked
+}
+
+func (w RightOfWay) yields(i int, rovers Rovers, routes map[int][]Coordinate, occupants map[Coordinate]int, remaining []int, blocked map[int]bool) bool {
+	for _, c := range routes[i] {
+		for j, other := range routes {
+			if j != i && passes(other, c) && !w.prefers(i, j, remaining) {
+				return true
+			}
+		}
+
+		occupant, occupied := occupants[c]
+		if !occupied || occupant == i {
+			continue
+		}
+
+		leaving, moving := routes[occupant]
+		switch {
+		case !moving || blocked[occupant]:
+			return true
+		case passes(leaving, rovers[i].Position.Coordinate):
+			//the occupant is heading through this Rover's Coordinate, neither can pass so both must wait
+			return true
+		}
+	}
+
+	return false
+}
+
+//passes reports whether the route passes through the Coordinate.
+func passes(route []Coordinate, c Coordinate) bool {
+	for _, at := range route {
+		if at == c {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Mission) runLockstep(ctx context.Context) (*Trace, error) {
+	occupied := make(map[Coordinate]bool, len(m.Rovers))
+	for _, r := range m.Rovers {
+		if occupied[r.Position.Coordinate] {
+			return nil, ErrRoversShareCoordinate
+		}
+		occupied[r.Position.Coordinate] = true
+	}
+
+	programs := make([][]Instruction, len(m.Rovers))
+	next := make([]int, len(m.Rovers))
+	remaining := make([]int, len(m.Rovers))
+	for i, r := range m.Rovers {
+		//commands were decoded when the mission was validated
+		programs[i], _ = r.decode(r.Commands)
+	}
+
+	trace := newTrace(m.Rovers)
+	for number := 1; ; number++ {
+		m.advance(number)
+		routes := make(map[int][]Coordinate)
+		faults := make([]Fault, len(m.Rovers))
+		active := 0
+		for i, r := range m.Rovers {
+			remaining[i] = len(programs[i]) - next[i]
+			if remaining[i] == 0 {
+				continue
+			}
+			active++
+
+			if err := r.cancelled(ctx, i, next[i]); err != nil {
+				return trace, err
+			}
+
+			//faults are rolled before any rover moves, so that a rover whose move fails is known to stay put
+			faults[i] = m.Faults.roll(r, programs[i][next[i]])
+			if programs[i][next[i]] != Move || faults[i] != NoFault {
+				continue
+			}
+
+			//a Rover which cannot move stays put, executing its move below returns the error
+			if route, err := r.route(*r.Position); err == nil && r.Battery.afford(Move, r.surcharge(Move)) == nil {
+				routes[i] = route
+			}
+		}
+
+		if active == 0 {
+			return trace, nil
+		}
+
+		blocked := m.RightOfWay.resolve(m.Rovers, routes, remaining)
+		tick := Tick{Number: number, Hazards: m.Rovers.hazards()}
+		m.Clock.stamp(&tick)
+		progressed := false
+		for i, r := range m.Rovers {
+			if remaining[i] == 0 {
+				continue
+			}
+
+			step := Step{
+				Rover:       i,
+				Name:        r.Name,
+				Instruction: programs[i][next[i]],
+				From:        *r.Position,
+				Yielded:     blocked[i],
+			}
+
+			var err error
+			if !step.Yielded {
+				step.Fault = faults[i]
+				err = r.executeFault(next[i], step.Instruction, step.Fault)
+				next[i]++
+				progressed = true
+			}
+
+			step.To = *r.Position
+			step.Believed, step.Uncertainty = r.Believed(), r.Odometry.uncertainty()
+			step.Charge = r.Battery.remaining()
+			step.Observed = r.Observe()
+			trace.observe(i, step.Observed)
+			tick.Steps = append(tick.Steps, step)
+
+			if err != nil {
+				trace.Ticks = append(trace.Ticks, tick)
+				return trace, err
+			}
+		}
+
+		//a Hazard drifting onto a Rover which yielded or stood still this tick runs into it
+		if err := m.collide(number); err != nil {
+			trace.Ticks = append(trace.Ticks, tick)
+			return trace, err
+		}
+
+		m.Clock.charge(m.Rovers, number)
+		for i := range tick.Steps {
+			tick.Steps[i].Charge = m.Rovers[tick.Steps[i].Rover].Battery.remaining()
+		}
+
+		trace.Ticks = append(trace.Ticks, tick)
+		if !progressed {
+			return trace, ErrLockstepDeadlock
+		}
+	}
+}
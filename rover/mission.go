@@ -0,0 +1,182 @@
+package rover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrMissionNotInitialised = errors.New("mission must not be nil")
+	ErrRoversShareCoordinate = errors.New("rovers must not start on the same coordinate")
+)
+
+//Mode describes how the Rovers of a Mission execute their commands.
+type Mode uint8
+
+const (
+	//Sequential runs each Rover to completion before the next Rover starts moving.
+	Sequential Mode = iota
+	//Lockstep runs every Rover at once, each executing one instruction per tick.
+	Lockstep
+)
+
+//Valid will return an error if the Mode is not Sequential or Lockstep.
+func (m Mode) Valid() error {
+	switch m {
+	case Sequential:
+	case Lockstep:
+	default:
+		return fmt.Errorf("mission provided unknown Mode{%d}", m)
+	}
+
+	return nil
+}
+
+//Mission is a squad of Rovers deployed together, along with the rules they are executed under.
+//Clock is optional, Missions without one have no day and night cycle. Faults is optional, Missions without it never
+//fault.
+type Mission struct {
+	Rovers     Rovers
+	Mode       Mode
+	RightOfWay RightOfWay
+	Clock      *Clock
+	Faults     *Faults
+}
+
+//Valid will return an error if the Mission, or any of its Rovers, is in a non-valid state.
+func (m *Mission) Valid() error {
+	if m == nil {
+		return ErrMissionNotInitialised
+	}
+
+	if err := m.Mode.Valid(); err != nil {
+		return err
+	}
+
+	if err := m.RightOfWay.Valid(); err != nil {
+		return err
+	}
+
+	if err := m.Clock.Valid(); err != nil {
+		return err
+	}
+
+	if err := m.Faults.Valid(); err != nil {
+		return err
+	}
+
+	return m.Rovers.Valid()
+}
+
+//Run executes the commands of every Rover using the Mission's Mode, returning a Trace of every tick.
+//If a Rover cannot perform an instruction the Mission stops and returns the Trace up to that point alongside the error.
+func (m *Mission) Run() (*Trace, error) {
+	return m.RunContext(context.Background())
+}
+
+//RunContext behaves like Run but checks ctx before every tick, returning a *CancelledError if ctx is cancelled before
+//every Rover has finished. In Lockstep the error holds the first Rover still executing, the Trace holds the others.
+func (m *Mission) RunContext(ctx context.Context) (*Trace, error) {
+	//every run starts before the first tick, so Geofences and Hazards are where a new Mission would find them, and
+	//ends by putting the Plateaus back so Rovers exploring them afterwards are not left on the last tick of the run
+	defer m.restore(m.ticks())
+	m.advance(0)
+	if err := m.Valid(); err != nil {
+		return nil, err
+	}
+
+	m.Faults.reset()
+	if m.Mode == Lockstep {
+		return m.runLockstep(ctx)
+	}
+
+	return m.runSequential(ctx)
+}
+
+func (m *Mission) runSequential(ctx context.Context) (*Trace, error) {
+	trace := newTrace(m.Rovers)
+
+	for i, r := range m.Rovers {
+		//commands were decoded when the mission was validated
+		instructions, _ := r.decode(r.Commands)
+		for s, instruction := range instructions {
+			if err := r.cancelled(ctx, i, s); err != nil {
+				return trace, err
+			}
+
+			m.advance(len(trace.Ticks) + 1)
+			step := Step{
+				Rover:       i,
+				Name:        r.Name,
+				Instruction: instruction,
+				From:        *r.Position,
+			}
+
+			step.Fault = m.Faults.roll(r, step.Instruction)
+			err := r.executeFault(s, step.Instruction, step.Fault)
+			step.To = *r.Position
+			step.Believed, step.Uncertainty = r.Believed(), r.Odometry.uncertainty()
+			step.Charge = r.Battery.remaining()
+			step.Observed = r.Observe()
+			trace.observe(i, step.Observed)
+			tick := Tick{
+				Number:  len(trace.Ticks) + 1,
+				Hazards: m.Rovers.hazards(),
+				Steps:   []Step{step},
+			}
+			m.Clock.stamp(&tick)
+			if err == nil {
+				err = m.collide(tick.Number)
+			}
+			if err == nil {
+				m.Clock.charge(m.Rovers, tick.Number)
+				tick.Steps[0].Charge = r.Battery.remaining()
+			}
+			trace.Ticks = append(trace.Ticks, tick)
+
+			if err != nil {
+				return trace, err
+			}
+		}
+	}
+
+	return trace, nil
+}
+
+//ticks returns the current tick of the Plateau of every Rover.
+func (m *Mission) ticks() map[*Plateau]int {
+	ticks := make(map[*Plateau]int)
+	if m == nil {
+		return ticks
+	}
+
+	for _, r := range m.Rovers {
+		if r != nil && r.Plateau != nil {
+			ticks[r.Plateau] = r.Plateau.Tick
+		}
+	}
+
+	return ticks
+}
+
+//restore moves each Plateau back on to the tick returned for it by ticks.
+func (m *Mission) restore(ticks map[*Plateau]int) {
+	for p, tick := range ticks {
+		p.advance(tick)
+	}
+}
+
+//advance moves the Plateau of every Rover on to the given tick, so that Geofences open and close and Hazards drift as
+//the Mission runs.
+func (m *Mission) advance(tick int) {
+	if m == nil {
+		return
+	}
+
+	for _, r := range m.Rovers {
+		if r != nil {
+			r.Plateau.advance(tick)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package rover
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMission_Run(t *testing.T) {
+	tests := map[string]struct {
+		mission      *Mission
+		expErr       error
+		expErrRover  int
+		expTicks     int
+		expPositions []Position
+	}{
+		"sequential mission runs rovers one after another": {
+			mission: &Mission{
+				Mode: Sequential,
+				Rovers: Rovers{
+					{Commands: "LMLMLMLMM", Position: &Position{Coordinate{1, 2}, North}, Boundary: &Coordinate{5, 5}},
+					{Commands: "MMRMMRMRRM", Position: &Position{Coordinate{3, 3}, East}, Boundary: &Coordinate{5, 5}},
+				},
+			},
+			expTicks:     19,
+			expPositions: []Position{{Coordinate{1, 3}, North}, {Coordinate{5, 1}, East}},
+		},
+		"lockstep mission runs the example rovers side by side": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "LMLMLMLMM", Position: &Position{Coordinate{1, 2}, North}, Boundary: &Coordinate{5, 5}},
+					{Commands: "MMRMMRMRRM", Position: &Position{Coordinate{3, 3}, East}, Boundary: &Coordinate{5, 5}},
+				},
+			},
+			expTicks:     10,
+			expPositions: []Position{{Coordinate{1, 3}, North}, {Coordinate{5, 1}, East}},
+		},
+		"lockstep rover follows another into the coordinate it leaves": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 2}},
+					{Commands: "M", Position: &Position{Coordinate{1, 0}, East}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expTicks:     1,
+			expPositions: []Position{{Coordinate{1, 0}, East}, {Coordinate{2, 0}, East}},
+		},
+		"lockstep first deployed rover has right of way to a shared target": {
+			mission: &Mission{
+				Mode:       Lockstep,
+				RightOfWay: FirstDeployed,
+				Rovers: Rovers{
+					{Commands: "MR", Position: &Position{Coordinate{0, 1}, East}, Boundary: &Coordinate{2, 2}},
+					{Commands: "M", Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expTicks:     3,
+			expPositions: []Position{{Coordinate{1, 1}, South}, {Coordinate{1, 0}, North}},
+			expErr:       ErrLockstepDeadlock,
+		},
+		"lockstep last deployed rover has right of way to a shared target": {
+			mission: &Mission{
+				Mode:       Lockstep,
+				RightOfWay: LastDeployed,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 1}, East}, Boundary: &Coordinate{2, 2}},
+					{Commands: "ML", Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expTicks:     3,
+			expPositions: []Position{{Coordinate{0, 1}, East}, {Coordinate{1, 1}, West}},
+			expErr:       ErrLockstepDeadlock,
+		},
+		"lockstep rover with most remaining has right of way to a shared target": {
+			mission: &Mission{
+				Mode:       Lockstep,
+				RightOfWay: MostRemaining,
+				Rovers: Rovers{
+					{Commands: "MR", Position: &Position{Coordinate{0, 1}, East}, Boundary: &Coordinate{2, 2}},
+					{Commands: "MML", Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expTicks:     3,
+			expPositions: []Position{{Coordinate{1, 1}, South}, {Coordinate{1, 2}, West}},
+		},
+		"lockstep rovers yield until a blocking rover moves away": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 2}},
+					{Commands: "LM", Position: &Position{Coordinate{1, 0}, East}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expTicks:     2,
+			expPositions: []Position{{Coordinate{1, 0}, East}, {Coordinate{1, 1}, North}},
+		},
+		"lockstep rover waits for another to pass": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "W2M", Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 2}},
+					{Commands: "MM", Position: &Position{Coordinate{0, 1}, East}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expTicks:     3,
+			expPositions: []Position{{Coordinate{1, 1}, North}, {Coordinate{2, 1}, East}},
+		},
+		"lockstep strider yields until a rover in its way moves on": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 2}, Model: strider},
+					{Commands: "WM", Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expTicks:     2,
+			expPositions: []Position{{Coordinate{2, 0}, East}, {Coordinate{1, 1}, North}},
+		},
+		"err lockstep strider cannot drive through a rover standing still": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 2}, Model: strider},
+					{Commands: "W", Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expTicks:     2,
+			expPositions: []Position{{Coordinate{0, 0}, East}, {Coordinate{1, 0}, North}},
+			expErr:       ErrLockstepDeadlock,
+		},
+		"err lockstep rover cannot slide through a rover standing on the ice": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}, Plateau: terrainPlateau(Ice)},
+					{Commands: "W", Position: &Position{Coordinate{0, 1}, East}, Boundary: &Coordinate{2, 2}, Plateau: terrainPlateau(Ice)},
+				},
+			},
+			expTicks:     2,
+			expPositions: []Position{{Coordinate{0, 0}, North}, {Coordinate{0, 1}, East}},
+			expErr:       ErrLockstepDeadlock,
+		},
+		"err lockstep rovers swapping coordinates deadlock": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 2}},
+					{Commands: "M", Position: &Position{Coordinate{1, 0}, West}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expTicks:     1,
+			expPositions: []Position{{Coordinate{0, 0}, East}, {Coordinate{1, 0}, West}},
+			expErr:       ErrLockstepDeadlock,
+		},
+		"err lockstep head on approach ends in deadlock": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "MM", Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 2}},
+					{Commands: "MM", Position: &Position{Coordinate{2, 0}, West}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expTicks:     2,
+			expPositions: []Position{{Coordinate{1, 0}, East}, {Coordinate{2, 0}, West}},
+			expErr:       ErrLockstepDeadlock,
+		},
+		"err lockstep rovers starting on the same coordinate": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 2}},
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+				},
+			},
+			expPositions: []Position{{Coordinate{0, 0}, East}, {Coordinate{0, 0}, North}},
+			expErr:       ErrRoversShareCoordinate,
+		},
+		"err lockstep rover leaving boundary": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "MM", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1}},
+					{Commands: "RRR", Position: &Position{Coordinate{1, 1}, North}, Boundary: &Coordinate{1, 1}},
+				},
+			},
+			expTicks:     2,
+			expPositions: []Position{{Coordinate{0, 1}, North}, {Coordinate{1, 1}, East}},
+			expErr: &MovementError{
+				Step:        1,
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 1}, North},
+				Attempted:   Coordinate{0, 2},
+				Err:         &OffPlateauError{Coordinate: Coordinate{0, 2}, Err: ErrBoundaryNorth},
+			},
+			expErrRover: 0,
+		},
+		"err rovers on plateaus of different sizes": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{5, 5}},
+					{Commands: "M", Position: &Position{Coordinate{3, 3}, North}, Boundary: &Coordinate{4, 5}},
+				},
+			},
+			expErr:       &ValidationError{Err: fmt.Errorf("%w : boundary (%d, %d) not (%d, %d)", ErrPlateauMismatch, 4, 5, 5, 5)},
+			expErrRover:  1,
+			expPositions: []Position{{Coordinate{0, 0}, North}, {Coordinate{3, 3}, North}},
+		},
+		"err rovers on different grids": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{5, 5}},
+					{Grid: Hex, Commands: "M", Position: &Position{Coordinate{3, 3}, East}, Boundary: &Coordinate{5, 5}},
+				},
+			},
+			expErr:       &ValidationError{Err: fmt.Errorf("%w : grid %d not %d", ErrPlateauMismatch, Hex, Square)},
+			expErrRover:  1,
+			expPositions: []Position{{Coordinate{0, 0}, North}, {Coordinate{3, 3}, East}},
+		},
+		"err rovers on different topologies": {
+			mission: &Mission{
+				Mode: Lockstep,
+				Rovers: Rovers{
+					{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{5, 5}},
+					{Topology: World, Commands: "M", Position: &Position{Coordinate{3, 3}, North}, Boundary: &Coordinate{5, 5}},
+				},
+			},
+			expErr:       &ValidationError{Err: fmt.Errorf("%w : topology %d not %d", ErrPlateauMismatch, World, Bounded)},
+			expErrRover:  1,
+			expPositions: []Position{{Coordinate{0, 0}, North}, {Coordinate{3, 3}, North}},
+		},
+		"err mission with unknown mode": {
+			mission: &Mission{
+				Mode: 255,
+			},
+			expErr: (Mode(255)).Valid(),
+		},
+	}
+
+	for desc, test := range tests {
+		trace, err := test.mission.Run()
+		if len(test.mission.Rovers) > 0 {
+			test.expErr = withRover(test.expErr, test.mission.Rovers[test.expErrRover])
+		}
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		if trace != nil {
+			assert.Equalf(t, test.expTicks, len(trace.Ticks), "%s failed, expected %d ticks but got %d", desc, test.expTicks, len(trace.Ticks))
+		}
+		for i, r := range test.mission.Rovers {
+			assert.Equalf(t, test.expPositions[i], *r.Position, "%s failed, expected rover %d at %v but got %v", desc, i, test.expPositions[i], *r.Position)
+		}
+	}
+}
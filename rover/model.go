@@ -0,0 +1,103 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnsupportedInstruction   = errors.New("rover model does not support the instruction")
+	ErrInvalidStride            = errors.New("rover model stride must not be negative")
+	ErrRoverOnImpassableTerrain = errors.New("rover cannot start on terrain it cannot cross")
+)
+
+//Model describes the capabilities shared by every Rover of the same kind. Instructions lists the Instructions the
+//Model supports, a Model without Instructions supports them all. Stride is the number of cells each Move travels, a
+//Stride of zero travels one. Battery, Camera, MaxSlope and Traversal are the Model's specification, which Deploy gives
+//to each Rover of the Model.
+type Model struct {
+	Name         string
+	Instructions []Instruction
+	Stride       int
+	Battery      *Battery
+	Camera       *Camera
+	MaxSlope     float64
+	Traversal    map[Terrain]Traversal
+}
+
+//Valid will return an error if the Model supports an unknown Instruction, has a negative Stride or slope limit, or
+//its Battery is not valid.
+func (m *Model) Valid() error {
+	if m == nil {
+		return nil
+	}
+
+	for _, instruction := range m.Instructions {
+		if err := instruction.Valid(); err != nil {
+			return err
+		}
+	}
+
+	if m.Stride < 0 {
+		return fmt.Errorf("%w : %d", ErrInvalidStride, m.Stride)
+	}
+
+	if m.MaxSlope < 0 {
+		return fmt.Errorf("%w : %g", ErrInvalidSlope, m.MaxSlope)
+	}
+
+	return m.Battery.Valid()
+}
+
+//Deploy makes the Rover one of the Model, giving it a full Battery and a Camera to the Model's specification along
+//with the Model's slope limit and Traversal. Anything the Rover already has of its own is kept.
+func (m *Model) Deploy(r *Rover) {
+	r.Model = m
+
+	if r.Battery == nil && m.Battery != nil {
+		battery := *m.Battery
+		battery.Charge = battery.Capacity
+		r.Battery = &battery
+	}
+
+	if r.Camera == nil && m.Camera != nil {
+		camera := *m.Camera
+		r.Camera = &camera
+	}
+
+	if r.MaxSlope == 0 {
+		r.MaxSlope = m.MaxSlope
+	}
+
+	if r.Traversal == nil && m.Traversal != nil {
+		r.Traversal = make(map[Terrain]Traversal, len(m.Traversal))
+		for terrain, traversal := range m.Traversal {
+			r.Traversal[terrain] = traversal
+		}
+	}
+}
+
+//supports returns ErrUnsupportedInstruction if the Model cannot perform the Instruction, a nil Model supports every
+//Instruction.
+func (m *Model) supports(i Instruction) error {
+	if m == nil || m.Instructions == nil {
+		return nil
+	}
+
+	for _, supported := range m.Instructions {
+		if supported == i {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w %q : %s", ErrUnsupportedInstruction, rune(i), m.Name)
+}
+
+//stride returns the number of cells each Move travels.
+func (m *Model) stride() int {
+	if m == nil || m.Stride < 1 {
+		return 1
+	}
+
+	return m.Stride
+}
@@ -0,0 +1,176 @@
+package rover
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	//noLeft is a model which cannot turn left.
+	noLeft = &Model{Name: "rightie", Instructions: []Instruction{Move, TurnRight, Wait}}
+	//strider is a model which moves two cells per instruction.
+	strider = &Model{Name: "strider", Stride: 2}
+)
+
+func TestRover_ExploreModel(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		expErr      error
+		expPosition Position
+		expCharge   float64
+	}{
+		"stride moves two cells per instruction": {
+			rover: &Rover{
+				Commands: "MRM",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{4, 4},
+				Model:    strider,
+			},
+			expPosition: Position{Coordinate{2, 2}, East},
+		},
+		"stride pays for the terrain of every cell": {
+			rover: &Rover{
+				Commands: "M",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{4, 4},
+				Plateau:  &Plateau{Terrain: map[Coordinate]Terrain{{0, 1}: Sand, {0, 2}: Rock}},
+				Battery:  &Battery{Capacity: 10, Charge: 10},
+				Model:    strider,
+			},
+			expPosition: Position{Coordinate{0, 2}, North},
+			expCharge:   7.5,
+		},
+		"err stride blocked part way does not move": {
+			rover: &Rover{
+				Commands: "M",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{4, 4},
+				Plateau:  &Plateau{Obstacles: map[Coordinate]bool{{0, 2}: true}},
+				Model:    strider,
+			},
+			expErr: &MovementError{
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 0}, North},
+				Attempted:   Coordinate{0, 1},
+				Err:         ErrObstacle,
+			},
+			expPosition: Position{Coordinate{0, 0}, North},
+		},
+		"err unsupported instruction": {
+			rover: &Rover{
+				Commands: "RL",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{4, 4},
+				Model:    noLeft,
+			},
+			expErr: &InvalidInstructionError{
+				Step:        1,
+				Instruction: TurnLeft,
+				Err:         fmt.Errorf("%w %q : %s", ErrUnsupportedInstruction, 'L', "rightie"),
+			},
+			expPosition: Position{Coordinate{0, 0}, East},
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Explore()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expPosition, *test.rover.Position, "%s failed, expected position %v but got %v", desc, test.expPosition, *test.rover.Position)
+		assert.Equalf(t, test.expCharge, test.rover.Battery.remaining(), "%s failed, expected charge %g but got %g", desc, test.expCharge, test.rover.Battery.remaining())
+	}
+}
+
+func TestRover_ValidModel(t *testing.T) {
+	tests := map[string]struct {
+		rover  *Rover
+		expErr error
+	}{
+		"supported instructions are valid": {
+			rover: &Rover{Commands: "MRW", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{4, 4}, Model: noLeft},
+		},
+		"err unsupported instruction": {
+			rover: &Rover{Commands: "MRML", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{4, 4}, Model: noLeft},
+			expErr: &ValidationError{Err: &InvalidInstructionError{
+				Step:        3,
+				Instruction: TurnLeft,
+				Err:         fmt.Errorf("%w %q : %s", ErrUnsupportedInstruction, 'L', "rightie"),
+			}},
+		},
+		"err negative stride": {
+			rover:  &Rover{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{4, 4}, Model: &Model{Stride: -1}},
+			expErr: &ValidationError{Err: fmt.Errorf("%w : %d", ErrInvalidStride, -1)},
+		},
+		"err starting on terrain the model cannot cross": {
+			rover: &Rover{Commands: "M", Position: &Position{Coordinate{0, 1}, North}, Boundary: &Coordinate{4, 4},
+				Plateau: terrainPlateau(Ice), Traversal: map[Terrain]Traversal{Ice: {Impassable: true}}},
+			expErr: &ValidationError{Err: fmt.Errorf("%w : %v", ErrRoverOnImpassableTerrain, Ice)},
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Valid()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+	}
+}
+
+func TestModel_Deploy(t *testing.T) {
+	model := &Model{
+		Name:      "scout",
+		Battery:   &Battery{Capacity: 50, Costs: map[Instruction]float64{Move: 2}},
+		Camera:    &Camera{Range: 3, Angle: 90},
+		MaxSlope:  1,
+		Traversal: map[Terrain]Traversal{Sand: {Impassable: true}},
+	}
+
+	scout := &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{4, 4}}
+	model.Deploy(scout)
+	assert.Equal(t, model, scout.Model)
+	assert.Equal(t, &Battery{Capacity: 50, Charge: 50, Costs: map[Instruction]float64{Move: 2}}, scout.Battery)
+	assert.Equal(t, &Camera{Range: 3, Angle: 90}, scout.Camera)
+	assert.Equal(t, 1.0, scout.MaxSlope)
+	assert.Equal(t, model.Traversal, scout.Traversal)
+
+	scout.Battery.Charge = 10
+	assert.Equal(t, 0.0, model.Battery.Charge, "expected each rover to have its own battery")
+
+	own := &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{4, 4}, Battery: &Battery{Capacity: 5, Charge: 1}, MaxSlope: 2}
+	model.Deploy(own)
+	assert.Equal(t, &Battery{Capacity: 5, Charge: 1}, own.Battery, "expected the rover to keep its own battery")
+	assert.Equal(t, 2.0, own.MaxSlope, "expected the rover to keep its own slope limit")
+}
+
+func TestPlan_Model(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		target      Coordinate
+		expCommands string
+	}{
+		"model without left turns turns right three times": {
+			rover:       &Rover{Position: &Position{Coordinate{1, 1}, North}, Boundary: &Coordinate{4, 4}, Model: noLeft},
+			target:      Coordinate{0, 1},
+			expCommands: "RRRM",
+		},
+		"strider covers two cells with each move": {
+			rover:       &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{4, 4}, Model: strider},
+			target:      Coordinate{0, 4},
+			expCommands: "MM",
+		},
+	}
+
+	for desc, test := range tests {
+		commands, err := Plan(test.rover, test.target, UnknownDirection, nil)
+		assert.NoErrorf(t, err, "%s failed, expected no error but got %v", desc, err)
+		assert.Equalf(t, test.expCommands, commands, "%s failed, expected commands %s but got %s", desc, test.expCommands, commands)
+	}
+
+	//a strider cannot pass through the rover on (0, 1), so it goes around
+	r := &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{4, 4}, Model: strider}
+	other := &Rover{Position: &Position{Coordinate{0, 1}, North}, Boundary: &Coordinate{4, 4}}
+	commands, err := Plan(r, Coordinate{0, 4}, UnknownDirection, Rovers{other})
+	assert.NoErrorf(t, err, "expected a plan around the rover but got %v", err)
+	assert.Equalf(t, "RMLMMLM", commands, "expected the strider to plan around the rover")
+}
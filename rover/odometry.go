@@ -0,0 +1,117 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var ErrInvalidOdometry = errors.New("rover odometry drift and uncertainty must not be negative")
+
+//Odometry is where a Rover believes it is, which drifts from its true Position whenever an instruction does not go as
+//commanded, such as a skipped move, an overshooting turn, or a slide on ice. Believed is updated by dead reckoning,
+//assuming every instruction executes exactly as commanded. Uncertainty is the radius, in cells, around the Believed
+//Coordinate the Rover expects to be within, which grows by Drift with every Move.
+type Odometry struct {
+	Believed    Position
+	Uncertainty float64
+	Drift       float64
+}
+
+//NewOdometry returns an Odometry which starts out certain the Rover is at the given Position.
+func NewOdometry(p Position, drift float64) *Odometry {
+	return &Odometry{Believed: p, Drift: drift}
+}
+
+//Valid will return an error if the Odometry has a negative drift or uncertainty, or believes the Rover faces an
+//unknown Direction on a Square Grid.
+func (o *Odometry) Valid() error {
+	return o.validOn(Square)
+}
+
+//validOn behaves like Valid but checks the believed Direction is one of the Headings of the given Grid.
+func (o *Odometry) validOn(grid Grid) error {
+	if o == nil {
+		return nil
+	}
+
+	if o.Drift < 0 || o.Uncertainty < 0 {
+		return fmt.Errorf("%w : drift %g, uncertainty %g", ErrInvalidOdometry, o.Drift, o.Uncertainty)
+	}
+
+	return grid.Heading(o.Believed.Direction)
+}
+
+//uncertainty returns the Uncertainty of the Odometry, a nil Odometry is certain.
+func (o *Odometry) uncertainty() float64 {
+	if o == nil {
+		return 0
+	}
+
+	return o.Uncertainty
+}
+
+//reckon updates the Believed Position as if the Instruction executed exactly as commanded by the Rover, with each
+//Move travelling the Rover's stride and wrapping around its Topology.
+func (o *Odometry) reckon(instruction Instruction, r *Rover) {
+	switch instruction {
+	case Move:
+		for i := 0; i < r.Model.stride(); i++ {
+			if ahead, err := o.Believed.ahead(); err == nil {
+				o.Believed = r.wrap(Position{Coordinate: ahead, Direction: o.Believed.Direction})
+			}
+		}
+		o.Uncertainty += o.Drift
+	case TurnLeft, TurnRight:
+		believer := Rover{Position: &o.Believed, Grid: r.Grid}
+		_ = believer.turn(instruction)
+	}
+}
+
+//sight corrects the Believed Position to the true Position when the Rover is on a landmark, shrinking the Uncertainty
+//to no more than the landmark's accuracy.
+func (o *Odometry) sight(truth Position, plateau *Plateau) {
+	accuracy, ok := plateau.Landmark(truth.Coordinate)
+	if !ok {
+		return
+	}
+
+	o.Believed = truth
+	o.Uncertainty = math.Min(o.Uncertainty, accuracy)
+}
+
+//AddLandmark marks the Coordinate as a landmark, which corrects the Odometry of any Rover on it. The accuracy is the
+//uncertainty a Rover is left with after sighting the landmark.
+func (p *Plateau) AddLandmark(c Coordinate, accuracy float64) {
+	if p.Landmarks == nil {
+		p.Landmarks = make(map[Coordinate]float64)
+	}
+	p.Landmarks[c] = accuracy
+}
+
+//Landmark returns the accuracy of the landmark on the Coordinate, and whether there is one.
+func (p *Plateau) Landmark(c Coordinate) (float64, bool) {
+	if p == nil {
+		return 0, false
+	}
+
+	accuracy, ok := p.Landmarks[c]
+
+	return accuracy, ok
+}
+
+//Believed returns the Position the Rover believes it is in, which is its true Position when it has no Odometry.
+func (r *Rover) Believed() Position {
+	if r.Odometry == nil {
+		return *r.Position
+	}
+
+	return r.Odometry.Believed
+}
+
+//LocalisationError returns the distance, in cells, between where the Rover believes it is and where it truly is.
+func (r *Rover) LocalisationError() float64 {
+	believed := r.Believed()
+
+	return r.Grid.distance(believed.Coordinate, r.Position.Coordinate)
+}
@@ -0,0 +1,132 @@
+package rover
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRover_ExploreOdometry(t *testing.T) {
+	landmark := terrainPlateau(Ice)
+	landmark.AddLandmark(Coordinate{0, 2}, 0.25)
+
+	tests := map[string]struct {
+		rover          *Rover
+		expPosition    Position
+		expBelieved    Position
+		expUncertainty float64
+		expError       float64
+	}{
+		"belief follows the rover and uncertainty grows with each move": {
+			rover: &Rover{
+				Commands: "MMRMW",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Odometry: NewOdometry(Position{Coordinate{0, 0}, North}, 0.5),
+			},
+			expPosition:    Position{Coordinate{1, 2}, East},
+			expBelieved:    Position{Coordinate{1, 2}, East},
+			expUncertainty: 1.5,
+		},
+		"belief drifts when the rover slides on ice": {
+			rover: &Rover{
+				Commands: "M",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  terrainPlateau(Ice),
+				Odometry: NewOdometry(Position{Coordinate{0, 0}, North}, 0.5),
+			},
+			expPosition:    Position{Coordinate{0, 2}, North},
+			expBelieved:    Position{Coordinate{0, 1}, North},
+			expUncertainty: 0.5,
+			expError:       1,
+		},
+		"landmark corrects the belief and shrinks the uncertainty": {
+			rover: &Rover{
+				Commands: "M",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  landmark,
+				Odometry: NewOdometry(Position{Coordinate{0, 0}, North}, 0.5),
+			},
+			expPosition:    Position{Coordinate{0, 2}, North},
+			expBelieved:    Position{Coordinate{0, 2}, North},
+			expUncertainty: 0.25,
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Explore()
+		assert.NoErrorf(t, err, "%s failed, expected no error but got %v", desc, err)
+		assert.Equalf(t, test.expPosition, *test.rover.Position, "%s failed, expected position %v but got %v", desc, test.expPosition, *test.rover.Position)
+		assert.Equalf(t, test.expBelieved, test.rover.Believed(), "%s failed, expected belief %v but got %v", desc, test.expBelieved, test.rover.Believed())
+		assert.Equalf(t, test.expUncertainty, test.rover.Odometry.Uncertainty, "%s failed, expected uncertainty %g but got %g", desc, test.expUncertainty, test.rover.Odometry.Uncertainty)
+		assert.Equalf(t, test.expError, test.rover.LocalisationError(), "%s failed, expected localisation error %g but got %g", desc, test.expError, test.rover.LocalisationError())
+	}
+}
+
+func TestMission_RunOdometry(t *testing.T) {
+	m := &Mission{
+		Faults: &Faults{Instructions: map[Instruction]FaultRates{TurnRight: {Overshoot: 1}}},
+		Rovers: Rovers{
+			{Commands: "RM", Position: &Position{Coordinate{1, 1}, North}, Boundary: &Coordinate{2, 2},
+				Odometry: NewOdometry(Position{Coordinate{1, 1}, North}, 1)},
+		},
+	}
+
+	trace, err := m.Run()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	believed := []Position{{Coordinate{1, 1}, East}, {Coordinate{2, 1}, East}}
+	truth := []Position{{Coordinate{1, 1}, South}, {Coordinate{1, 0}, South}}
+	uncertainty := []float64{0, 1}
+	for i, tick := range trace.Ticks {
+		step := tick.Steps[0]
+		assert.Equalf(t, believed[i], step.Believed, "expected belief %v on tick %d but got %v", believed[i], tick.Number, step.Believed)
+		assert.Equalf(t, truth[i], step.To, "expected position %v on tick %d but got %v", truth[i], tick.Number, step.To)
+		assert.Equalf(t, uncertainty[i], step.Uncertainty, "expected uncertainty %g on tick %d but got %g", uncertainty[i], tick.Number, step.Uncertainty)
+	}
+}
+
+func TestPlan_Belief(t *testing.T) {
+	r := &Rover{
+		Position: &Position{Coordinate{0, 0}, North},
+		Boundary: &Coordinate{2, 2},
+		Odometry: &Odometry{Believed: Position{Coordinate{1, 0}, North}, Uncertainty: 1},
+	}
+
+	commands, err := Plan(r, Coordinate{1, 2}, UnknownDirection, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "MM", commands, "expected commands planned from where the rover believes it is")
+
+	exp := &Odometry{Believed: Position{Coordinate{1, 0}, North}, Uncertainty: 1}
+	assert.Equalf(t, exp, r.Odometry, "expected planning to leave the rover's belief unchanged but got %v", r.Odometry)
+}
+
+func TestOdometry_Valid(t *testing.T) {
+	tests := map[string]struct {
+		odometry *Odometry
+		expErr   error
+	}{
+		"no odometry is valid": {},
+		"odometry with drift is valid": {
+			odometry: NewOdometry(Position{Coordinate{0, 0}, North}, 0.5),
+		},
+		"err negative drift": {
+			odometry: NewOdometry(Position{Coordinate{0, 0}, North}, -1),
+			expErr:   fmt.Errorf("%w : drift %g, uncertainty %g", ErrInvalidOdometry, -1.0, 0.0),
+		},
+		"err believed direction unknown": {
+			odometry: NewOdometry(Position{Coordinate{0, 0}, UnknownDirection}, 0),
+			expErr:   errUnknownDirection(UnknownDirection),
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.odometry.Valid()
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+	}
+}
@@ -0,0 +1,162 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrNoPayload       = errors.New("rover has no payload")
+	ErrNoSampleSite    = errors.New("no sample site at the rover's coordinate")
+	ErrAlreadySampled  = errors.New("rover already holds a sample from this site")
+	ErrStorageFull     = errors.New("rover sample storage is full")
+	ErrInvalidCapacity = errors.New("rover sample storage capacity must not be negative")
+)
+
+//Sample is material collected from a sample site.
+type Sample struct {
+	Site       string
+	Coordinate Coordinate
+}
+
+//Photo is a photograph taken of the Coordinate ahead of a Rover. Site is the name of the sample site photographed,
+//if there is one.
+type Photo struct {
+	From    Position
+	Subject Coordinate
+	Site    string
+}
+
+//Payload holds the science a Rover collects. Capacity is the number of Samples the Rover can store, Photos take no
+//storage.
+type Payload struct {
+	Capacity int
+	Samples  []Sample
+	Photos   []Photo
+}
+
+//Valid will return an error if the Payload has a negative capacity or holds more Samples than it can store.
+func (p *Payload) Valid() error {
+	if p == nil {
+		return nil
+	}
+
+	if p.Capacity < 0 || len(p.Samples) > p.Capacity {
+		return fmt.Errorf("%w : %d of %d", ErrInvalidCapacity, len(p.Samples), p.Capacity)
+	}
+
+	return nil
+}
+
+//AddSampleSite marks the Coordinate as a sample site with the given name.
+func (p *Plateau) AddSampleSite(c Coordinate, name string) {
+	if p.SampleSites == nil {
+		p.SampleSites = make(map[Coordinate]string)
+	}
+	p.SampleSites[c] = name
+}
+
+//SampleSite returns the name of the sample site on the Coordinate, and whether there is one.
+func (p *Plateau) SampleSite(c Coordinate) (string, bool) {
+	if p == nil {
+		return "", false
+	}
+
+	name, ok := p.SampleSites[c]
+
+	return name, ok
+}
+
+//sample collects a Sample from the sample site the Rover is on.
+func (r *Rover) sample() error {
+	if r.Payload == nil {
+		return ErrNoPayload
+	}
+
+	site, ok := r.Plateau.SampleSite(r.Position.Coordinate)
+	if !ok {
+		return ErrNoSampleSite
+	}
+
+	for _, sample := range r.Payload.Samples {
+		if sample.Site == site {
+			return fmt.Errorf("%w %s", ErrAlreadySampled, site)
+		}
+	}
+
+	if len(r.Payload.Samples) >= r.Payload.Capacity {
+		return fmt.Errorf("%w : %d of %d", ErrStorageFull, len(r.Payload.Samples), r.Payload.Capacity)
+	}
+
+	r.Payload.Samples = append(r.Payload.Samples, Sample{Site: site, Coordinate: r.Position.Coordinate})
+
+	return nil
+}
+
+//photograph takes a Photo of the Coordinate ahead of the Rover, which may be beyond its boundary unless the plateau
+//is a World and the Coordinate wraps around.
+func (r *Rover) photograph() error {
+	if r.Payload == nil {
+		return ErrNoPayload
+	}
+
+	subject, err := r.Position.ahead()
+	if err != nil {
+		return err
+	}
+	subject = r.wrap(Position{Coordinate: subject, Direction: r.Position.Direction}).Coordinate
+
+	site, _ := r.Plateau.SampleSite(subject)
+	r.Payload.Photos = append(r.Payload.Photos, Photo{From: *r.Position, Subject: subject, Site: site})
+
+	return nil
+}
+
+//Report lists the science each Rover of a Mission has collected.
+type Report struct {
+	Rovers []RoverReport
+}
+
+//RoverReport lists the Samples and Photos collected by a single Rover, Rover is its index within the Mission.
+type RoverReport struct {
+	Rover   int
+	Name    string
+	Samples []Sample
+	Photos  []Photo
+}
+
+//Report returns the Samples and Photos collected so far by each Rover of the Mission, Rovers without a Payload have
+//collected nothing.
+func (m *Mission) Report() *Report {
+	report := &Report{Rovers: make([]RoverReport, len(m.Rovers))}
+	for i, r := range m.Rovers {
+		report.Rovers[i] = RoverReport{Rover: i, Name: r.Name}
+		if r.Payload != nil {
+			report.Rovers[i].Samples = append([]Sample{}, r.Payload.Samples...)
+			report.Rovers[i].Photos = append([]Photo{}, r.Payload.Photos...)
+		}
+	}
+
+	return report
+}
+
+//String writes the Report with a line for each Rover, by name or else by index, followed by a line for each Sample,
+//giving its site and Coordinate.
+func (rep *Report) String() string {
+	var b strings.Builder
+	for _, rover := range rep.Rovers {
+		name := rover.Name
+		if name == "" {
+			name = strconv.Itoa(rover.Rover)
+		}
+
+		fmt.Fprintf(&b, "Rover %s collected %d samples and took %d photos\n", name, len(rover.Samples), len(rover.Photos))
+		for _, sample := range rover.Samples {
+			fmt.Fprintf(&b, "    %s at (%d, %d)\n", sample.Site, sample.Coordinate.X, sample.Coordinate.Y)
+		}
+	}
+
+	return b.String()
+}
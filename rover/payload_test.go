@@ -0,0 +1,152 @@
+package rover
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//sites is a plateau with sample sites alpha at (0, 1) and beta at (0, 2).
+func sites() *Plateau {
+	p := &Plateau{}
+	p.AddSampleSite(Coordinate{0, 1}, "alpha")
+	p.AddSampleSite(Coordinate{0, 2}, "beta")
+
+	return p
+}
+
+func TestRover_ExplorePayload(t *testing.T) {
+	tests := map[string]struct {
+		rover      *Rover
+		expErr     error
+		expPayload *Payload
+	}{
+		"samples and photographs are stored": {
+			rover: &Rover{
+				Commands: "MSPMS",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  sites(),
+				Payload:  &Payload{Capacity: 2},
+			},
+			expPayload: &Payload{
+				Capacity: 2,
+				Samples:  []Sample{{"alpha", Coordinate{0, 1}}, {"beta", Coordinate{0, 2}}},
+				Photos:   []Photo{{From: Position{Coordinate{0, 1}, North}, Subject: Coordinate{0, 2}, Site: "beta"}},
+			},
+		},
+		"photographs beyond the boundary": {
+			rover: &Rover{
+				Commands: "P",
+				Position: &Position{Coordinate{0, 0}, West},
+				Boundary: &Coordinate{2, 2},
+				Payload:  &Payload{},
+			},
+			expPayload: &Payload{
+				Photos: []Photo{{From: Position{Coordinate{0, 0}, West}, Subject: Coordinate{-1, 0}}},
+			},
+		},
+		"err storage full": {
+			rover: &Rover{
+				Commands: "MSMS",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  sites(),
+				Payload:  &Payload{Capacity: 1},
+			},
+			expErr: &MovementError{
+				Step:        3,
+				Instruction: TakeSample,
+				Position:    Position{Coordinate{0, 2}, North},
+				Attempted:   Coordinate{0, 2},
+				Err:         fmt.Errorf("%w : %d of %d", ErrStorageFull, 1, 1),
+			},
+			expPayload: &Payload{Capacity: 1, Samples: []Sample{{"alpha", Coordinate{0, 1}}}},
+		},
+		"err site already sampled": {
+			rover: &Rover{
+				Commands: "MSS",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  sites(),
+				Payload:  &Payload{Capacity: 2},
+			},
+			expErr: &MovementError{
+				Step:        2,
+				Instruction: TakeSample,
+				Position:    Position{Coordinate{0, 1}, North},
+				Attempted:   Coordinate{0, 1},
+				Err:         fmt.Errorf("%w %s", ErrAlreadySampled, "alpha"),
+			},
+			expPayload: &Payload{Capacity: 2, Samples: []Sample{{"alpha", Coordinate{0, 1}}}},
+		},
+		"err no sample site": {
+			rover: &Rover{
+				Commands: "S",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  sites(),
+				Payload:  &Payload{Capacity: 2},
+			},
+			expErr: &MovementError{
+				Instruction: TakeSample,
+				Position:    Position{Coordinate{0, 0}, North},
+				Attempted:   Coordinate{0, 0},
+				Err:         ErrNoSampleSite,
+			},
+			expPayload: &Payload{Capacity: 2},
+		},
+		"err no payload": {
+			rover: &Rover{
+				Commands: "P",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+			},
+			expErr: &MovementError{
+				Instruction: Photograph,
+				Position:    Position{Coordinate{0, 0}, North},
+				Attempted:   Coordinate{0, 0},
+				Err:         ErrNoPayload,
+			},
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Explore()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expPayload, test.rover.Payload, "%s failed, expected payload %v but got %v", desc, test.expPayload, test.rover.Payload)
+	}
+}
+
+func TestMission_Report(t *testing.T) {
+	m := &Mission{
+		Mode: Lockstep,
+		Rovers: Rovers{
+			{Name: "Spirit", Commands: "MSMS", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+				Plateau: sites(), Payload: &Payload{Capacity: 2}},
+			{Commands: "LP", Position: &Position{Coordinate{1, 2}, North}, Boundary: &Coordinate{2, 2},
+				Plateau: sites(), Payload: &Payload{Capacity: 2}},
+			{Commands: "R", Position: &Position{Coordinate{2, 0}, North}, Boundary: &Coordinate{2, 2}},
+		},
+	}
+
+	_, err := m.Run()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	report := m.Report()
+	assert.Equal(t, &Report{Rovers: []RoverReport{
+		{Rover: 0, Name: "Spirit", Samples: []Sample{{"alpha", Coordinate{0, 1}}, {"beta", Coordinate{0, 2}}}, Photos: []Photo{}},
+		{Rover: 1, Samples: []Sample{}, Photos: []Photo{{From: Position{Coordinate{1, 2}, West}, Subject: Coordinate{0, 2}, Site: "beta"}}},
+		{Rover: 2},
+	}}, report)
+
+	assert.Equal(t, "Rover Spirit collected 2 samples and took 0 photos\n"+
+		"    alpha at (0, 1)\n"+
+		"    beta at (0, 2)\n"+
+		"Rover 1 collected 0 samples and took 1 photos\n"+
+		"Rover 2 collected 0 samples and took 0 photos\n", report.String())
+}
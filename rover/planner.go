@@ -0,0 +1,185 @@
+package rover
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+)
+
+var ErrTargetUnreachable = errors.New("rover cannot reach target")
+
+//UnreachableError is returned by Plan when no sequence of commands can take the Rover to the target.
+//It wraps ErrTargetUnreachable.
+type UnreachableError struct {
+	Rover  *Rover
+	From   Position
+	Target Coordinate
+	Facing Direction
+	Err    error
+}
+
+func (e *UnreachableError) Error() string {
+	return fmt.Sprintf("%v at (%d, %d) planning to (%d, %d) : %v", e.Rover, e.From.X, e.From.Y, e.Target.X, e.Target.Y, e.Err)
+}
+
+func (e *UnreachableError) Unwrap() error {
+	return e.Err
+}
+
+//planInstructions are the instructions the planner may use, in the order they are explored.
+var planInstructions = []Instruction{Move, TurnLeft, TurnRight}
+
+//Plan returns the cheapest command string which takes the Rover from its Position to the target Coordinate, finishing
+//facing the given Direction, or facing any Direction if UnknownDirection is given. The commands keep the Rover within
+//its boundary, away from obstacles, out of Geofences which are active now or become active later, and away from the
+//Positions of the other Rovers. Rovers with Odometry are planned from, and planned around, where they believe they
+//are rather than their true Positions. Commands cost one for each instruction plus the Traversal cost of the Terrain
+//moved onto, so on Ground the cheapest commands are the shortest.
+//The Rover itself is not moved.
+//If the target cannot be reached an *UnreachableError is returned.
+func Plan(r *Rover, target Coordinate, facing Direction, others Rovers) (string, error) {
+	if err := r.validPlacement(); err != nil {
+		return "", err
+	}
+
+	if facing != UnknownDirection {
+		if err := r.Grid.Heading(facing); err != nil {
+			return "", err
+		}
+	}
+
+	occupied := make(map[Coordinate]bool, len(others))
+	for _, other := range others {
+		if other != nil && other != r && other.Position != nil {
+			occupied[other.Believed().Coordinate] = true
+		}
+	}
+
+	arrived := func(p Position) bool {
+		return p.Coordinate == target && (facing == UnknownDirection || p.Direction == facing)
+	}
+
+	commands, _, found := r.search(r.Believed(), occupied, arrived)
+	if !found {
+		return "", &UnreachableError{
+			Rover:  r,
+			From:   r.Believed(),
+			Target: target,
+			Facing: facing,
+			Err:    ErrTargetUnreachable,
+		}
+	}
+
+	return commands, nil
+}
+
+//search explores outwards from the start Position one instruction at a time, cheapest first, avoiding the occupied
+//Coordinates, and returns the commands to reach the cheapest Position for which arrived is true along with that
+//Position. Each instruction costs one, plus the Traversal cost of any Terrain it moves onto, and instructions of equal
+//cost are explored in the order they were found.
+func (r *Rover) search(start Position, occupied map[Coordinate]bool, arrived func(Position) bool) (string, Position, bool) {
+	type edge struct {
+		from        Position
+		instruction Instruction
+		cost        float64
+	}
+
+	visited := map[Position]edge{start: {}}
+	done := make(map[Position]bool)
+	open := &planQueue{{position: start}}
+	for found := 1; open.Len() > 0; {
+		current := heap.Pop(open).(planNode).position
+		if done[current] {
+			continue
+		}
+		done[current] = true
+
+		if arrived(current) {
+			var commands []rune
+			for p := current; p != start; p = visited[p].from {
+				commands = append([]rune{rune(visited[p].instruction)}, commands...)
+			}
+
+			return string(commands), current, true
+		}
+
+		for _, instruction := range planInstructions {
+			next, err := r.after(current, instruction)
+			if err != nil || r.crosses(current, instruction, next, occupied) {
+				continue
+			}
+
+			cost := visited[current].cost + 1 + r.entryCost(current, instruction)
+			if previous, seen := visited[next]; !seen || cost < previous.cost {
+				visited[next] = edge{from: current, instruction: instruction, cost: cost}
+				heap.Push(open, planNode{position: next, cost: cost, order: found})
+				found++
+			}
+		}
+	}
+
+	return "", start, false
+}
+
+//after returns the Position the Rover would be in after performing the Instruction from the given Position, without
+//moving the Rover. The Rover's Battery is ignored, so planners do not account for energy, as is its Odometry, so
+//exploring a plan does not change what the Rover believes. Planners cannot tell when a plan will reach each
+//Coordinate, so a Move into a Geofence which is active now or becomes active later fails.
+func (r *Rover) after(p Position, instruction Instruction) (Position, error) {
+	scratch := *r
+	scratch.Position = &p
+	scratch.Battery = nil
+	scratch.Odometry = nil
+
+	from := p.Coordinate
+	err := scratch.execute(0, instruction)
+	if fence, closing := r.Plateau.closing(p.Coordinate); err == nil && closing && p.Coordinate != from {
+		err = &GeofenceError{Coordinate: p.Coordinate, Fence: fence.Name, Tick: r.Plateau.Tick}
+	}
+
+	return p, err
+}
+
+//crosses reports whether performing the Instruction from one Position to the other passes through or finishes on any
+//of the occupied Coordinates.
+func (r *Rover) crosses(from Position, instruction Instruction, to Position, occupied map[Coordinate]bool) bool {
+	for _, c := range r.sweep(from, instruction, to) {
+		if occupied[c] {
+			return true
+		}
+	}
+
+	return false
+}
+
+//planNode is a Position found by search, along with the cost of reaching it and the order it was found in.
+type planNode struct {
+	position Position
+	cost     float64
+	order    int
+}
+
+//planQueue is a priority queue of planNodes, cheapest first and then earliest found.
+type planQueue []planNode
+
+func (q planQueue) Len() int { return len(q) }
+
+func (q planQueue) Less(i, j int) bool {
+	if q[i].cost != q[j].cost {
+		return q[i].cost < q[j].cost
+	}
+
+	return q[i].order < q[j].order
+}
+
+func (q planQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *planQueue) Push(x interface{}) { *q = append(*q, x.(planNode)) }
+
+func (q *planQueue) Pop() interface{} {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return n
+}
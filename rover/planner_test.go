@@ -0,0 +1,93 @@
+package rover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlan(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		target      Coordinate
+		facing      Direction
+		others      Rovers
+		expCommands string
+		expErr      error
+	}{
+		"already at target": {
+			rover:       &Rover{Position: &Position{Coordinate{1, 1}, North}, Boundary: &Coordinate{2, 2}},
+			target:      Coordinate{1, 1},
+			expCommands: "",
+		},
+		"straight ahead": {
+			rover:       &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{3, 3}},
+			target:      Coordinate{0, 3},
+			expCommands: "MMM",
+		},
+		"turn to face final direction": {
+			rover:       &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{3, 3}},
+			target:      Coordinate{0, 1},
+			facing:      West,
+			expCommands: "ML",
+		},
+		"route around obstacles": {
+			rover: &Rover{
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  &Plateau{Obstacles: map[Coordinate]bool{{0, 1}: true, {1, 1}: true}},
+			},
+			target:      Coordinate{0, 2},
+			facing:      West,
+			expCommands: "RMMLMMLMM",
+		},
+		"route around other rovers": {
+			rover:       &Rover{Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{2, 1}},
+			target:      Coordinate{2, 0},
+			others:      Rovers{{Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{2, 1}}},
+			expCommands: "LMRMMRM",
+		},
+		"err target outside boundary": {
+			rover:  &Rover{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2}},
+			target: Coordinate{3, 3},
+			expErr: &UnreachableError{
+				From:   Position{Coordinate{0, 0}, North},
+				Target: Coordinate{3, 3},
+				Err:    ErrTargetUnreachable,
+			},
+		},
+		"err target walled off by obstacles": {
+			rover: &Rover{
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  &Plateau{Obstacles: map[Coordinate]bool{{1, 2}: true, {2, 1}: true}},
+			},
+			target: Coordinate{2, 2},
+			facing: East,
+			expErr: &UnreachableError{
+				From:   Position{Coordinate{0, 0}, North},
+				Target: Coordinate{2, 2},
+				Facing: East,
+				Err:    ErrTargetUnreachable,
+			},
+		},
+	}
+
+	for desc, test := range tests {
+		start := *test.rover.Position
+		commands, err := Plan(test.rover, test.target, test.facing, test.others)
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, len(test.expCommands), len(commands), "%s failed, expected commands like %s but got %s", desc, test.expCommands, commands)
+		assert.Equalf(t, start, *test.rover.Position, "%s failed, expected planning not to move the rover", desc)
+
+		if err == nil && commands != "" {
+			test.rover.Commands = commands
+			assert.Nilf(t, test.rover.Explore(), "%s failed, expected planned commands %s to explore cleanly", desc, commands)
+			assert.Equalf(t, test.target, test.rover.Position.Coordinate, "%s failed, expected planned commands %s to reach %v", desc, commands, test.target)
+			if test.facing != UnknownDirection {
+				assert.Equalf(t, test.facing, test.rover.Position.Direction, "%s failed, expected to face %d", desc, test.facing)
+			}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package rover
+
+//Plateau describes the surface the Rovers explore beyond its boundary, such as the Coordinates of obstacles which no
+//Rover can enter, the elevation of the ground, its Terrain, the landmarks Rovers can correct their Odometry against,
+//the named sites Rovers can take Samples from, the Shape of an irregular plateau, the Geofences closing parts of it and
+//the Hazards drifting over it. A nil Plateau is flat, clear Ground without landmarks, sites, Geofences or Hazards,
+//covering the whole of its boundary. Tick is the current tick of the Mission exploring the Plateau, which decides the
+//Geofences that are active and where the Hazards are. A Rover exploring on its own counts its instructions on from
+//Tick, and both it and a Mission leave the Plateau on the Tick they found it on when they stop.
+type Plateau struct {
+	Shape       Shape
+	Obstacles   map[Coordinate]bool
+	Elevation   map[Coordinate]float64
+	Terrain     map[Coordinate]Terrain
+	Landmarks   map[Coordinate]float64
+	SampleSites map[Coordinate]string
+	Geofences   []Geofence
+	Hazards     []Hazard
+	Tick        int
+}
+
+//AddObstacle marks the Coordinate as an obstacle.
+func (p *Plateau) AddObstacle(c Coordinate) {
+	if p.Obstacles == nil {
+		p.Obstacles = make(map[Coordinate]bool)
+	}
+	p.Obstacles[c] = true
+}
+
+//Blocked reports whether the Coordinate holds an obstacle.
+func (p *Plateau) Blocked(c Coordinate) bool {
+	return p != nil && p.Obstacles[c]
+}
+
+//now returns the Plateau's current tick, a nil Plateau is always on tick 0.
+func (p *Plateau) now() int {
+	if p == nil {
+		return 0
+	}
+
+	return p.Tick
+}
+
+//advance moves the Plateau on to the given tick.
+func (p *Plateau) advance(tick int) {
+	if p != nil {
+		p.Tick = tick
+	}
+}
@@ -0,0 +1,101 @@
+package rover
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	glyphGround   = '.'
+	glyphObstacle = '#'
+	glyphOff      = ' '
+	glyphHazard   = '*'
+	//elevationLevels is the number of digits used to draw elevation, from 0 at the lowest to 9 at the highest.
+	elevationLevels = 10
+)
+
+//roverGlyphs draws a Rover as an arrow pointing in its Direction.
+var roverGlyphs = map[Direction]rune{
+	North:     '^',
+	East:      '>',
+	South:     'v',
+	West:      '<',
+	NorthEast: '↗',
+	SouthEast: '↘',
+	SouthWest: '↙',
+	NorthWest: '↖',
+}
+
+//Render draws the plateau shared by the Rovers as text, one line for each row from north to south. Rovers are drawn
+//as arrows facing their Direction, Hazards where they are on the plateau's current Tick as '*', obstacles as '#' and
+//Terrain other than Ground by its TerrainLegend character. When the plateau has an elevation, Ground is drawn as a
+//digit from 0 at its lowest to 9 at its highest, otherwise it is drawn as '.'. Cells outside the plateau's Shape are
+//drawn as spaces. The plateau of the first Rover is drawn from its origin to its boundary, an empty squad draws
+//nothing. On a Hex Grid cells are separated by spaces and each row is indented half a cell further than the row below,
+//so that neighbouring cells touch.
+func (rs Rovers) Render() string {
+	if len(rs) == 0 || rs[0] == nil || rs[0].Boundary == nil {
+		return ""
+	}
+
+	origin, boundary, plateau, grid := rs[0].Origin, *rs[0].Boundary, rs[0].Plateau, rs[0].Grid
+	low, high := plateau.heightRange(origin, boundary)
+
+	rovers := make(map[Coordinate]Direction, len(rs))
+	for _, r := range rs {
+		if r != nil && r.Position != nil {
+			rovers[r.Position.Coordinate] = r.Position.Direction
+		}
+	}
+
+	var b strings.Builder
+	for y := boundary.Y; y >= origin.Y; y-- {
+		if grid == Hex {
+			b.WriteString(strings.Repeat(" ", y-origin.Y))
+		}
+
+		for x := origin.X; x <= boundary.X; x++ {
+			if grid == Hex && x > origin.X {
+				b.WriteRune(' ')
+			}
+
+			c := Coordinate{X: x, Y: y}
+
+			direction, occupied := rovers[c]
+			_, drifting := plateau.HazardAt(c)
+			switch {
+			case occupied:
+				b.WriteRune(roverGlyphs[direction])
+			case !plateau.Within(c):
+				b.WriteRune(glyphOff)
+			case drifting:
+				b.WriteRune(glyphHazard)
+			case plateau.Blocked(c):
+				b.WriteRune(glyphObstacle)
+			case plateau.TerrainAt(c) != Ground:
+				b.WriteRune(plateau.TerrainAt(c).glyph())
+			case high > low:
+				level := int((plateau.Height(c) - low) / (high - low) * elevationLevels)
+				b.WriteRune(rune('0' + int(math.Min(float64(level), elevationLevels-1))))
+			default:
+				b.WriteRune(glyphGround)
+			}
+		}
+		b.WriteRune('\n')
+	}
+
+	return b.String()
+}
+
+//heightRange returns the lowest and highest elevation within the rectangle from the origin to the boundary.
+func (p *Plateau) heightRange(origin, boundary Coordinate) (float64, float64) {
+	low, high := math.Inf(1), math.Inf(-1)
+	for y := origin.Y; y <= boundary.Y; y++ {
+		for x := origin.X; x <= boundary.X; x++ {
+			height := p.Height(Coordinate{X: x, Y: y})
+			low, high = math.Min(low, height), math.Max(high, height)
+		}
+	}
+
+	return low, high
+}
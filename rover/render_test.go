@@ -0,0 +1,55 @@
+package rover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRovers_Render(t *testing.T) {
+	tests := map[string]struct {
+		rovers Rovers
+		exp    string
+	}{
+		"no rovers draw nothing": {},
+		"flat plateau with obstacles": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{1, 2}, North}, Boundary: &Coordinate{3, 2},
+					Plateau: &Plateau{Obstacles: map[Coordinate]bool{{2, 1}: true}}},
+				{Position: &Position{Coordinate{0, 0}, West}, Boundary: &Coordinate{3, 2}},
+			},
+			exp: ".^..\n" +
+				"..#.\n" +
+				"<...\n",
+		},
+		"terrain drawn with its legend": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 1},
+					Plateau: &Plateau{Terrain: map[Coordinate]Terrain{{0, 1}: Sand, {1, 1}: Rock, {2, 0}: Ice}}},
+			},
+			exp: "sr.\n" +
+				"^.i\n",
+		},
+		"elevation drawn from lowest to highest": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{2, 0}, East}, Boundary: &Coordinate{2, 2}, Plateau: ramp()},
+			},
+			exp: "689\n" +
+				"024\n" +
+				"02>\n",
+		},
+		"plateau drawn from a negative origin": {
+			rovers: Rovers{
+				{Position: &Position{Coordinate{-1, -1}, South}, Origin: Coordinate{-1, -1}, Boundary: &Coordinate{1, 0},
+					Plateau: &Plateau{Obstacles: map[Coordinate]bool{{1, 0}: true}}},
+			},
+			exp: "..#\n" +
+				"v..\n",
+		},
+	}
+
+	for desc, test := range tests {
+		rendered := test.rovers.Render()
+		assert.Equalf(t, test.exp, rendered, "%s failed, expected\n%s\nbut got\n%s", desc, test.exp, rendered)
+	}
+}
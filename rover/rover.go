@@ -1,8 +1,10 @@
 package rover
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math"
 )
 
 var (
@@ -10,152 +12,595 @@ var (
 	ErrBoundaryEast           = errors.New("rover at X edge cannot move east")
 	ErrBoundarySouth          = errors.New("rover at Y edge cannot move south")
 	ErrBoundaryWest           = errors.New("rover at X edge cannot move west")
+	ErrObstacle               = errors.New("rover cannot move onto an obstacle")
+	ErrRoverOnObstacle        = errors.New("rover must not start on an obstacle")
 	ErrRoverOutsideXBoundary  = errors.New("rover x coordinate must be within boundary")
-	ErrRoverOutsideYBoundary  = errors.New("rover x coordinate must be within boundary")
+	ErrRoverOutsideYBoundary  = errors.New("rover y coordinate must be within boundary")
+	ErrNegativeBoundary       = errors.New("rover has a boundary below its origin but should not")
 	ErrRoverRequiresCommands  = errors.New("rover must have at least one valid command")
 	ErrRoverNotInitialised    = errors.New("rover must not be nil")
 	ErrPositionNotInitialised = errors.New("rover position must not be nil")
+	ErrRoverNotFound          = errors.New("no rover with that name")
+	ErrDuplicateRoverName     = errors.New("rover names must be unique")
+	ErrPlateauMismatch        = errors.New("rovers must share the corners, grid and topology of their plateau")
 )
 
+//Rovers is a squad of Rovers, in the order they were deployed.
 type Rovers []*Rover
 
+//Find returns the Rover with the given name, or ErrRoverNotFound if there is no such Rover.
+func (rs Rovers) Find(name string) (*Rover, error) {
+	if i := rs.Index(name); i >= 0 {
+		return rs[i], nil
+	}
+
+	return nil, fmt.Errorf("%w : %s", ErrRoverNotFound, name)
+}
+
+//Index returns the index of the Rover with the given name, or -1 if there is no such Rover.
+func (rs Rovers) Index(name string) int {
+	for i, r := range rs {
+		if r != nil && r.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+//Names returns the name of each Rover in order, unnamed Rovers have an empty name.
+func (rs Rovers) Names() []string {
+	names := make([]string, len(rs))
+	for i, r := range rs {
+		if r != nil {
+			names[i] = r.Name
+		}
+	}
+
+	return names
+}
+
+//Valid will return an error if any Rover is not valid, if two Rovers share a name, or if the Rovers disagree about the
+//shape of the plateau they are on.
+func (rs Rovers) Valid() error {
+	names := make(map[string]bool, len(rs))
+	for _, r := range rs {
+		if err := r.Valid(); err != nil {
+			return err
+		}
+
+		if err := rs[0].samePlateau(r); err != nil {
+			return &ValidationError{Rover: r, Err: err}
+		}
+
+		if r.Name == "" {
+			continue
+		}
+		if names[r.Name] {
+			return &ValidationError{Rover: r, Err: fmt.Errorf("%w : %s", ErrDuplicateRoverName, r.Name)}
+		}
+		names[r.Name] = true
+	}
+
+	return nil
+}
+
+//samePlateau returns an ErrPlateauMismatch if the other Rover has a different Origin, Boundary, Grid or Topology.
+func (r *Rover) samePlateau(other *Rover) error {
+	switch {
+	case other.Origin != r.Origin:
+		return fmt.Errorf("%w : origin (%d, %d) not (%d, %d)", ErrPlateauMismatch, other.Origin.X, other.Origin.Y, r.Origin.X, r.Origin.Y)
+	case *other.Boundary != *r.Boundary:
+		return fmt.Errorf("%w : boundary (%d, %d) not (%d, %d)", ErrPlateauMismatch, other.Boundary.X, other.Boundary.Y,
+			r.Boundary.X, r.Boundary.Y)
+	case other.Grid != r.Grid:
+		return fmt.Errorf("%w : grid %d not %d", ErrPlateauMismatch, other.Grid, r.Grid)
+	case other.Topology != r.Topology:
+		return fmt.Errorf("%w : topology %d not %d", ErrPlateauMismatch, other.Topology, r.Topology)
+	}
+
+	return nil
+}
+
 //Position details the location and direction a Rover is on Mars.
 type Position struct {
 	Coordinate
 	Direction
 }
 
-
 //Rover represents a rover which is used to explore the Mars surface.
+//Origin is the lower-left corner of the plateau and Boundary the upper-right, the zero Origin is at (0, 0).
+//Name is optional, but must be unique within a Mission when provided. Plateau is optional, Rovers without one
+//explore a flat and clear surface. Camera is optional, Rovers without one observe nothing. Battery is optional,
+//Rovers without one never run out of energy. MaxSlope is the steepest change in elevation the Rover can drive up or
+//down in a single move, a MaxSlope of zero places no limit. Traversal sets how the Rover crosses each Terrain,
+//Terrains missing from Traversal use DefaultTraversal, as does a Rover without a Traversal. Odometry is optional,
+//Rovers without one always know their true Position. Payload is optional, Rovers without one cannot take Samples or
+//Photos. Model is optional, Rovers without one support every Instruction and move one cell at a time. Grid is the
+//shape of the plateau's cells, the zero Grid is Square. Topology is what lies beyond the edges of the plateau, the
+//zero Topology is Bounded.
 type Rover struct {
-	Commands string
-	Position *Position
-	Boundary *Coordinate
+	Name      string
+	Commands  string
+	Position  *Position
+	Grid      Grid
+	Topology  Topology
+	Origin    Coordinate
+	Boundary  *Coordinate
+	Plateau   *Plateau
+	Camera    *Camera
+	Battery   *Battery
+	MaxSlope  float64
+	Traversal map[Terrain]Traversal
+	Odometry  *Odometry
+	Payload   *Payload
+	Model     *Model
 }
 
 //Explore is used to execute the instructions that belong to the rover, allowing it to traverse the Mars surface
 //up to its boundaries, if the Rover cannot perform an instruction it will return an error.
 func (r *Rover) Explore() error {
-	for _, command := range r.Commands {
-		instruction := Instruction(command)
-		switch instruction {
-		case Move:
-			if err := r.move(); err != nil {
-				return err
-			}
-		case TurnLeft:
-			if err := r.turn(instruction); err != nil {
-				return err
-			}
-		case TurnRight:
+	return r.ExploreContext(context.Background())
+}
+
+//clone returns a copy of the Rover which can be moved, and whose Battery can be drawn on, without changing the
+//original. The Plateau and Camera are shared as Rovers never change them.
+func (r *Rover) clone() *Rover {
+	c := *r
+
+	if r.Position != nil {
+		position := *r.Position
+		c.Position = &position
+	}
+
+	if r.Boundary != nil {
+		boundary := *r.Boundary
+		c.Boundary = &boundary
+	}
+
+	if r.Battery != nil {
+		battery := *r.Battery
+		c.Battery = &battery
+	}
+
+	if r.Odometry != nil {
+		odometry := *r.Odometry
+		c.Odometry = &odometry
+	}
+
+	if r.Payload != nil {
+		payload := *r.Payload
+		payload.Samples = append([]Sample{}, r.Payload.Samples...)
+		payload.Photos = append([]Photo{}, r.Payload.Photos...)
+		c.Payload = &payload
+	}
+
+	return &c
+}
+
+//String returns the Rover's name for use in output, or "rover" if it has not been named.
+func (r *Rover) String() string {
+	if r == nil || r.Name == "" {
+		return "rover"
+	}
+
+	return "rover " + r.Name
+}
+
+//execute performs a single Instruction, returning a *MovementError or *InvalidInstructionError if the Rover cannot
+//perform it. Step is the index of the Instruction within the Rover's commands.
+func (r *Rover) execute(step int, instruction Instruction) error {
+	return r.executeFault(step, instruction, NoFault)
+}
+
+//executeFault executes the Instruction with the Fault injected into it.
+func (r *Rover) executeFault(step int, instruction Instruction, fault Fault) error {
+	err := instruction.Valid()
+	if err == nil {
+		err = r.Model.supports(instruction)
+	}
+	if err != nil {
+		return &InvalidInstructionError{
+			Rover:       r,
+			Step:        step,
+			Instruction: instruction,
+			Err:         err,
+		}
+	}
+
+	position := *r.Position
+	surcharge := r.surcharge(instruction)
+	err = r.Battery.afford(instruction, surcharge)
+	if err == nil {
+		err = r.perform(instruction, fault)
+	}
+
+	if err != nil {
+		attempted := position.Coordinate
+		if instruction == Move {
+			attempted, _ = position.ahead()
+			attempted = r.wrap(Position{Coordinate: attempted, Direction: position.Direction}).Coordinate
+		}
+
+		return &MovementError{
+			Rover:       r,
+			Step:        step,
+			Instruction: instruction,
+			Position:    position,
+			Attempted:   attempted,
+			Err:         err,
+		}
+	}
+
+	r.Battery.draw(instruction, surcharge)
+	if r.Odometry != nil {
+		r.Odometry.reckon(instruction, r)
+		r.Odometry.sight(*r.Position, r.Plateau)
+	}
+
+	return nil
+}
+
+//surcharge returns the energy the Instruction uses on top of its cost, for the elevation a Move climbs and the Terrain
+//it moves onto.
+func (r *Rover) surcharge(instruction Instruction) float64 {
+	if instruction != Move || r.Battery == nil {
+		return 0
+	}
+
+	stepped, _, err := r.stepsFrom(*r.Position)
+	if err != nil {
+		return 0
+	}
+
+	var surcharge float64
+	from := r.Position.Coordinate
+	for _, c := range stepped {
+		surcharge += r.Battery.ClimbCost*math.Max(0, r.Plateau.Slope(from, c)) + r.traversal(c).Cost
+		from = c
+	}
+
+	return surcharge
+}
+
+//perform carries out a valid Instruction, suffering the Fault.
+func (r *Rover) perform(instruction Instruction, fault Fault) error {
+	switch {
+	case fault == Stall:
+		return ErrMotorStalled
+	case fault == SkippedMove:
+		return nil
+	case instruction == Move:
+		return r.move()
+	case instruction == TurnLeft || instruction == TurnRight:
+		if fault == Overshoot {
 			if err := r.turn(instruction); err != nil {
 				return err
 			}
-		default:
-			return fmt.Errorf("rover provided unknown Instruction{%d}", instruction)
 		}
+		return r.turn(instruction)
+	case instruction == TakeSample:
+		return r.sample()
+	case instruction == Photograph:
+		return r.photograph()
 	}
 
 	return nil
 }
 
-//Valid will return an error if the Rover is in a non-valid state, such as out of boundaries or facing an unknown
-//direction.
+//Valid will return a *ValidationError if the Rover is in a non-valid state, such as out of boundaries or facing an
+//unknown direction.
 func (r *Rover) Valid() error {
+	if err := r.validPlacement(); err != nil {
+		return err
+	}
+
+	//check instructions
+	if len(r.Commands) < 1 {
+		return &ValidationError{Rover: r, Err: ErrRoverRequiresCommands}
+	}
+
+	if err := r.validCommands(r.Commands); err != nil {
+		return &ValidationError{Rover: r, Err: err}
+	}
+
+	return nil
+}
+
+//validPlacement will return a *ValidationError if the Rover is outside its boundaries or facing an unknown direction.
+func (r *Rover) validPlacement() error {
 	if r == nil {
-		return ErrRoverNotInitialised
+		return &ValidationError{Err: ErrRoverNotInitialised}
 	}
 
 	//check boundaries
 	switch {
-	case r.Boundary.X < 0:
-		return fmt.Errorf("rover has a negative x boundary %d but should not", r.Boundary.X)
-	case r.Boundary.Y < 0:
-		return fmt.Errorf("rover has a negative y boundary %d but should not", r.Boundary.Y)
+	case r.Boundary.X < r.Origin.X:
+		return &ValidationError{Rover: r, Err: fmt.Errorf("%w : x boundary %d", ErrNegativeBoundary, r.Boundary.X)}
+	case r.Boundary.Y < r.Origin.Y:
+		return &ValidationError{Rover: r, Err: fmt.Errorf("%w : y boundary %d", ErrNegativeBoundary, r.Boundary.Y)}
+	}
+
+	if err := r.validTopology(); err != nil {
+		return &ValidationError{Rover: r, Err: err}
+	}
+
+	if err := r.Plateau.validShape(); err != nil {
+		return &ValidationError{Rover: r, Err: err}
+	}
+
+	if err := r.Plateau.validGeofences(); err != nil {
+		return &ValidationError{Rover: r, Err: err}
+	}
+
+	if err := r.Plateau.validHazards(); err != nil {
+		return &ValidationError{Rover: r, Err: err}
 	}
 
 	//check positions
 	switch {
 	case r.Position == nil:
-		return ErrPositionNotInitialised
-	case r.Position.X < 0:
-		return ErrRoverOutsideXBoundary
+		return &ValidationError{Rover: r, Err: ErrPositionNotInitialised}
+	case r.Position.X < r.Origin.X:
+		return &ValidationError{Rover: r, Err: ErrRoverOutsideXBoundary}
 	case r.Position.X > r.Boundary.X:
-		return ErrRoverOutsideXBoundary
-	case r.Position.Y < 0:
-		return ErrRoverOutsideYBoundary
+		return &ValidationError{Rover: r, Err: ErrRoverOutsideXBoundary}
+	case r.Position.Y < r.Origin.Y:
+		return &ValidationError{Rover: r, Err: ErrRoverOutsideYBoundary}
 	case r.Position.Y > r.Boundary.Y:
-		return ErrRoverOutsideYBoundary
+		return &ValidationError{Rover: r, Err: ErrRoverOutsideYBoundary}
+	case !r.Plateau.Within(r.Position.Coordinate):
+		return &ValidationError{Rover: r, Err: &OffPlateauError{Coordinate: r.Position.Coordinate, Err: ErrOffPlateau}}
+	case r.Plateau.Blocked(r.Position.Coordinate):
+		return &ValidationError{Rover: r, Err: ErrRoverOnObstacle}
+	}
+
+	if h, hit := r.Plateau.HazardAt(r.Position.Coordinate); hit {
+		return &ValidationError{Rover: r, Err: &CollisionError{Rover: r, Hazard: h.Name, Coordinate: r.Position.Coordinate,
+			Tick: r.Plateau.Tick}}
 	}
 
 	//check direction
-	if err := r.Position.Direction.Valid(); err != nil {
-		return err
+	if err := r.Grid.Valid(); err != nil {
+		return &ValidationError{Rover: r, Err: err}
 	}
 
-	//check instructions
-	if len(r.Commands) < 1 {
-		return ErrRoverRequiresCommands
+	if err := r.Grid.Heading(r.Position.Direction); err != nil {
+		return &ValidationError{Rover: r, Err: err}
 	}
-	for _, command := range r.Commands {
-		if err := Instruction(command).Valid(); err != nil {
-			return err
+
+	if r.MaxSlope < 0 {
+		return &ValidationError{Rover: r, Err: fmt.Errorf("%w : %g", ErrInvalidSlope, r.MaxSlope)}
+	}
+
+	if err := r.validTraversal(); err != nil {
+		return &ValidationError{Rover: r, Err: err}
+	}
+
+	if r.traversal(r.Position.Coordinate).Impassable {
+		return &ValidationError{Rover: r, Err: fmt.Errorf("%w : %v", ErrRoverOnImpassableTerrain,
+			r.Plateau.TerrainAt(r.Position.Coordinate))}
+	}
+
+	if err := r.Model.Valid(); err != nil {
+		return &ValidationError{Rover: r, Err: err}
+	}
+
+	if err := r.Odometry.validOn(r.Grid); err != nil {
+		return &ValidationError{Rover: r, Err: err}
+	}
+
+	if err := r.Payload.Valid(); err != nil {
+		return &ValidationError{Rover: r, Err: err}
+	}
+
+	//check battery
+	if err := r.Battery.Valid(); err != nil {
+		return &ValidationError{Rover: r, Err: err}
+	}
+
+	return nil
+}
+
+//validCommands will return an *InvalidInstructionError if the commands do not decode into valid Instructions.
+func (r *Rover) validCommands(commands string) error {
+	instructions, err := r.decode(commands)
+	if err != nil {
+		return err
+	}
+
+	for step, instruction := range instructions {
+		if err := r.Model.supports(instruction); err != nil {
+			return &InvalidInstructionError{Rover: r, Step: step, Instruction: instruction, Err: err}
 		}
 	}
 
 	return nil
 }
 
+//decode decodes the commands, setting the Rover on any error.
+func (r *Rover) decode(commands string) ([]Instruction, error) {
+	instructions, err := DecodeCommands(commands)
+	if invalid, ok := err.(*InvalidInstructionError); ok {
+		invalid.Rover = r
+	}
+
+	return instructions, err
+}
+
 func (r *Rover) move() error {
-	switch r.Position.Direction {
-	case North:
-		if r.Position.Y+1 <= r.Boundary.Y {
-			r.Position.Y += 1
-		} else {
-			return ErrBoundaryNorth
+	destination, err := r.destination()
+	if err != nil {
+		return err
+	}
+
+	*r.Position = destination
+
+	return nil
+}
+
+//destination returns the Position a Move finishes on, which faces a different Direction only if the Move crossed a
+//pole of a World.
+func (r *Rover) destination() (Position, error) {
+	_, destination, err := r.stepsFrom(*r.Position)
+
+	return destination, err
+}
+
+//route returns every Coordinate a Move from the given Position passes through, ending on the Coordinate it finishes
+//on.
+func (r *Rover) route(from Position) ([]Coordinate, error) {
+	stepped, to, err := r.stepsFrom(from)
+	if err != nil {
+		return nil, err
+	}
+
+	if stepped[len(stepped)-1] != to.Coordinate {
+		stepped = append(stepped, to.Coordinate)
+	}
+
+	return stepped, nil
+}
+
+//sweep returns every Coordinate the Rover passes through performing the Instruction from one Position to the other,
+//ending on the Coordinate it finishes on. Only a Move passes through anywhere other than where it finishes.
+func (r *Rover) sweep(from Position, instruction Instruction, to Position) []Coordinate {
+	if instruction == Move && from.Coordinate != to.Coordinate {
+		if cells, err := r.route(from); err == nil {
+			return cells
 		}
-	case East:
-		if r.Position.X+1 <= r.Boundary.X {
-			r.Position.X += 1
-		} else {
-			return ErrBoundaryEast
+	}
+
+	return []Coordinate{to.Coordinate}
+}
+
+//stepsFrom returns the Coordinates a Move from the given Position steps onto, one for each cell of the Rover's
+//stride, and the Position it finishes on. That is one beyond the last Coordinate stepped onto when the Rover slides
+//on its Terrain and can move on from there. If any step cannot be taken the Move fails as a whole.
+func (r *Rover) stepsFrom(from Position) ([]Coordinate, Position, error) {
+	walker := *r
+	at := from
+	walker.Position = &at
+
+	stepped := make([]Coordinate, 0, r.Model.stride())
+	for i := 0; i < r.Model.stride(); i++ {
+		ahead, err := walker.ahead()
+		if err != nil {
+			return stepped, from, err
 		}
-	case South:
-		if r.Position.Y-1 >= 0 {
-			r.Position.Y -= 1
-		} else {
-			return ErrBoundarySouth
+
+		stepped = append(stepped, ahead.Coordinate)
+		at = ahead
+	}
+
+	if r.traversal(at.Coordinate).Slide {
+		if beyond, err := walker.ahead(); err == nil {
+			at = beyond
 		}
+	}
+
+	return stepped, at, nil
+}
+
+//contains reports whether the Coordinate is within the rectangle from the Rover's origin to its boundary, and within
+//the Shape of its Plateau.
+func (r *Rover) contains(c Coordinate) bool {
+	return c.X >= r.Origin.X && c.Y >= r.Origin.Y && c.X <= r.Boundary.X && c.Y <= r.Boundary.Y && r.Plateau.Within(c)
+}
+
+//ahead returns the Position the Rover would be in one step in front of it, or an error if that Coordinate is off the
+//plateau, an obstacle, a Hazard, inside an active Geofence, too steep to reach, or impassable Terrain. On a World the
+//step wraps around the edges of the plateau instead of leaving it.
+func (r *Rover) ahead() (Position, error) {
+	c, err := r.Position.ahead()
+	if err != nil {
+		return *r.Position, err
+	}
+
+	next := r.wrap(Position{Coordinate: c, Direction: r.Position.Direction})
+	ahead := next.Coordinate
+
+	switch {
+	case ahead.Y > r.Boundary.Y:
+		return *r.Position, &OffPlateauError{Coordinate: ahead, Err: ErrBoundaryNorth}
+	case ahead.X > r.Boundary.X:
+		return *r.Position, &OffPlateauError{Coordinate: ahead, Err: ErrBoundaryEast}
+	case ahead.Y < r.Origin.Y:
+		return *r.Position, &OffPlateauError{Coordinate: ahead, Err: ErrBoundarySouth}
+	case ahead.X < r.Origin.X:
+		return *r.Position, &OffPlateauError{Coordinate: ahead, Err: ErrBoundaryWest}
+	case !r.Plateau.Within(ahead):
+		return *r.Position, &OffPlateauError{Coordinate: ahead, Err: ErrOffPlateau}
+	case r.Plateau.Blocked(ahead):
+		return *r.Position, ErrObstacle
+	}
+
+	if h, hit := r.Plateau.HazardAt(ahead); hit {
+		return *r.Position, &CollisionError{Hazard: h.Name, Coordinate: ahead, Tick: r.Plateau.Tick}
+	}
+
+	if fence, fenced := r.Plateau.Fenced(ahead); fenced {
+		return *r.Position, &GeofenceError{Coordinate: ahead, Fence: fence.Name, Tick: r.Plateau.Tick}
+	}
+
+	if slope := r.Plateau.Slope(r.Position.Coordinate, ahead); r.MaxSlope > 0 && math.Abs(slope) > r.MaxSlope {
+		return *r.Position, &SlopeError{From: r.Position.Coordinate, To: ahead, Slope: slope, Limit: r.MaxSlope}
+	}
+
+	if r.traversal(ahead).Impassable {
+		return *r.Position, &TerrainError{Coordinate: ahead, Terrain: r.Plateau.TerrainAt(ahead)}
+	}
+
+	return next, nil
+}
+
+//ahead returns the Coordinate directly in front of the Position, regardless of any boundary.
+func (p Position) ahead() (Coordinate, error) {
+	ahead := p.Coordinate
+
+	switch p.Direction {
+	case North:
+		ahead.Y += 1
+	case East:
+		ahead.X += 1
+	case South:
+		ahead.Y -= 1
 	case West:
-		if r.Position.X-1 >= 0 {
-			r.Position.X -= 1
-		} else {
-			return ErrBoundaryWest
-		}
+		ahead.X -= 1
+	case NorthEast:
+		ahead.Y += 1
+	case SouthEast:
+		ahead.X += 1
+		ahead.Y -= 1
+	case SouthWest:
+		ahead.Y -= 1
+	case NorthWest:
+		ahead.X -= 1
+		ahead.Y += 1
 	default:
-		return errUnknownDirection(r.Position.Direction)
+		return ahead, errUnknownDirection(p.Direction)
 	}
 
-	return nil
+	return ahead, nil
 }
 
+//turn rotates the Rover to the next of its Grid's Headings, a quarter turn on a Square Grid and a sixth on a Hex Grid.
 func (r *Rover) turn(i Instruction) error {
+	headings := r.Grid.Headings()
+	at := r.Grid.turns(r.Position.Direction)
+	if at < 0 {
+		return errUnknownDirection(r.Position.Direction)
+	}
+
 	switch i {
 	case TurnLeft:
-		newPos := r.Position.Direction - 1
-		if newPos >= 1 {
-			r.Position.Direction = newPos
-		} else {
-			r.Position.Direction = West
-		}
+		at = (at + len(headings) - 1) % len(headings)
 	case TurnRight:
-		r.Position.Direction += 1
-		if r.Position.Direction > 4 {
-			r.Position.Direction = North
-		}
+		at = (at + 1) % len(headings)
 	default:
 		return fmt.Errorf("unknown instruction passed to update direction %v", i)
 	}
+	r.Position.Direction = headings[at]
 
 	return nil
 }
@@ -138,6 +138,30 @@ func TestRover_Explore(t *testing.T) {
 			},
 			expErr: nil,
 		},
+		"waiting does not move or turn": {
+			rover: &Rover{
+				Commands: "MW3M",
+				Position: &Position{
+					Coordinate: Coordinate{
+						X: 0,
+						Y: 0,
+					},
+					Direction: North,
+				},
+				Boundary: &Coordinate{
+					X: 2,
+					Y: 2,
+				},
+			},
+			expPosition: &Position{
+				Coordinate: Coordinate{
+					X: 0,
+					Y: 2,
+				},
+				Direction: North,
+			},
+			expErr: nil,
+		},
 		"err trying to leave northern boundary": {
 			rover: &Rover{
 				Commands: "MM",
@@ -160,7 +184,13 @@ func TestRover_Explore(t *testing.T) {
 				},
 				Direction: North,
 			},
-			expErr: ErrBoundaryNorth,
+			expErr: &MovementError{
+				Step:        1,
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 1}, North},
+				Attempted:   Coordinate{0, 2},
+				Err:         &OffPlateauError{Coordinate: Coordinate{0, 2}, Err: ErrBoundaryNorth},
+			},
 		},
 		"err trying to leave eastern boundary": {
 			rover: &Rover{
@@ -184,7 +214,13 @@ func TestRover_Explore(t *testing.T) {
 				},
 				Direction: East,
 			},
-			expErr: ErrBoundaryEast,
+			expErr: &MovementError{
+				Step:        1,
+				Instruction: Move,
+				Position:    Position{Coordinate{1, 0}, East},
+				Attempted:   Coordinate{2, 0},
+				Err:         &OffPlateauError{Coordinate: Coordinate{2, 0}, Err: ErrBoundaryEast},
+			},
 		},
 		"err trying to leave southern boundary": {
 			rover: &Rover{
@@ -208,7 +244,12 @@ func TestRover_Explore(t *testing.T) {
 				},
 				Direction: South,
 			},
-			expErr: ErrBoundarySouth,
+			expErr: &MovementError{
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 0}, South},
+				Attempted:   Coordinate{0, -1},
+				Err:         &OffPlateauError{Coordinate: Coordinate{0, -1}, Err: ErrBoundarySouth},
+			},
 		},
 		"err trying to leave western boundary": {
 			rover: &Rover{
@@ -232,7 +273,12 @@ func TestRover_Explore(t *testing.T) {
 				},
 				Direction: West,
 			},
-			expErr: ErrBoundaryWest,
+			expErr: &MovementError{
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 0}, West},
+				Attempted:   Coordinate{-1, 0},
+				Err:         &OffPlateauError{Coordinate: Coordinate{-1, 0}, Err: ErrBoundaryWest},
+			},
 		},
 		"err dealing with unknown instruction": {
 			rover: &Rover{
@@ -256,7 +302,11 @@ func TestRover_Explore(t *testing.T) {
 				},
 				Direction: East,
 			},
-			expErr: fmt.Errorf("rover provided unknown Instruction{%d}", 'X'),
+			expErr: &InvalidInstructionError{
+				Step:        1,
+				Instruction: 'X',
+				Err:         fmt.Errorf("%w{%d}", ErrUnknownInstruction, 'X'),
+			},
 		},
 		"err rover facing unknown direction, does not move": {
 			rover: &Rover{
@@ -280,12 +330,43 @@ func TestRover_Explore(t *testing.T) {
 				},
 				Direction: UnknownDirection,
 			},
-			expErr: errUnknownDirection(UnknownDirection),
+			expErr: &MovementError{
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 0}, UnknownDirection},
+				Attempted:   Coordinate{0, 0},
+				Err:         errUnknownDirection(UnknownDirection),
+			},
+		},
+		"explore across negative coordinates from a non-zero origin": {
+			rover: &Rover{
+				Commands: "MMRMMM",
+				Position: &Position{Coordinate{-2, -2}, North},
+				Origin:   Coordinate{-2, -2},
+				Boundary: &Coordinate{2, 2},
+			},
+			expPosition: &Position{Coordinate{1, 0}, East},
+		},
+		"err explore past a negative origin": {
+			rover: &Rover{
+				Commands: "LMM",
+				Position: &Position{Coordinate{-1, 0}, North},
+				Origin:   Coordinate{-2, -2},
+				Boundary: &Coordinate{2, 2},
+			},
+			expPosition: &Position{Coordinate{-2, 0}, West},
+			expErr: &MovementError{
+				Step:        2,
+				Instruction: Move,
+				Position:    Position{Coordinate{-2, 0}, West},
+				Attempted:   Coordinate{-3, 0},
+				Err:         &OffPlateauError{Coordinate: Coordinate{-3, 0}, Err: ErrBoundaryWest},
+			},
 		},
 	}
 
 	for desc, test := range tests {
 		err := test.rover.Explore()
+		test.expErr = withRover(test.expErr, test.rover)
 		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
 		assert.Equalf(t, test.expPosition.X, test.rover.Position.X, "%s failed, expected x position %d but got %d", desc, test.expPosition.X, test.rover.Position.X)
 		assert.Equalf(t, test.expPosition.Y, test.rover.Position.Y, "%s failed, expected y position %d but got %d", desc, test.expPosition.Y, test.rover.Position.Y)
@@ -311,7 +392,7 @@ func TestRover_Valid(t *testing.T) {
 		},
 		"err if rover not init": {
 			rover:  nil,
-			expErr: ErrRoverNotInitialised,
+			expErr: &ValidationError{Err: ErrRoverNotInitialised},
 		},
 		"err if rover.Boundary.X negative value throws error": {
 			rover: &Rover{
@@ -322,7 +403,7 @@ func TestRover_Valid(t *testing.T) {
 				},
 				Boundary: &Coordinate{-1, 1},
 			},
-			expErr: fmt.Errorf("rover has a negative x boundary %d but should not", -1),
+			expErr: &ValidationError{Err: fmt.Errorf("%w : x boundary %d", ErrNegativeBoundary, -1)},
 		},
 		"err if rover.Boundary.Y negative value throws error": {
 			rover: &Rover{
@@ -333,7 +414,7 @@ func TestRover_Valid(t *testing.T) {
 				},
 				Boundary: &Coordinate{1, -1},
 			},
-			expErr: fmt.Errorf("rover has a negative y boundary %d but should not", -1),
+			expErr: &ValidationError{Err: fmt.Errorf("%w : y boundary %d", ErrNegativeBoundary, -1)},
 		},
 		"err if rover.Position not init": {
 			rover: &Rover{
@@ -341,7 +422,7 @@ func TestRover_Valid(t *testing.T) {
 				Position: nil,
 				Boundary: &Coordinate{1, 1},
 			},
-			expErr: ErrPositionNotInitialised,
+			expErr: &ValidationError{Err: ErrPositionNotInitialised},
 		},
 		"err if rover.Position.X less than 0": {
 			rover: &Rover{
@@ -352,7 +433,7 @@ func TestRover_Valid(t *testing.T) {
 				},
 				Boundary: &Coordinate{1, 1},
 			},
-			expErr: ErrRoverOutsideXBoundary,
+			expErr: &ValidationError{Err: ErrRoverOutsideXBoundary},
 		},
 		"err if rover.Position.X greater than boundary.X": {
 			rover: &Rover{
@@ -363,7 +444,7 @@ func TestRover_Valid(t *testing.T) {
 				},
 				Boundary: &Coordinate{1, 1},
 			},
-			expErr: ErrRoverOutsideXBoundary,
+			expErr: &ValidationError{Err: ErrRoverOutsideXBoundary},
 		},
 		"err if rover.Position.Y less than 0": {
 			rover: &Rover{
@@ -374,7 +455,7 @@ func TestRover_Valid(t *testing.T) {
 				},
 				Boundary: &Coordinate{1, 1},
 			},
-			expErr: ErrRoverOutsideYBoundary,
+			expErr: &ValidationError{Err: ErrRoverOutsideYBoundary},
 		},
 		"err if rover.Position.Y greater than boundary.Y": {
 			rover: &Rover{
@@ -385,7 +466,7 @@ func TestRover_Valid(t *testing.T) {
 				},
 				Boundary: &Coordinate{1, 1},
 			},
-			expErr: ErrRoverOutsideYBoundary,
+			expErr: &ValidationError{Err: ErrRoverOutsideYBoundary},
 		},
 		"err if rover.Position.Direction is not valid": {
 			rover: &Rover{
@@ -396,7 +477,7 @@ func TestRover_Valid(t *testing.T) {
 				},
 				Boundary: &Coordinate{1, 1},
 			},
-			expErr: errUnknownDirection(Direction(255)),
+			expErr: &ValidationError{Err: errUnknownDirection(Direction(255))},
 		},
 		"err if rover.Commands has no commands": {
 			rover: &Rover{
@@ -407,7 +488,7 @@ func TestRover_Valid(t *testing.T) {
 				},
 				Boundary: &Coordinate{1, 1},
 			},
-			expErr: ErrRoverRequiresCommands,
+			expErr: &ValidationError{Err: ErrRoverRequiresCommands},
 		},
 		"err if rover.Commands has invalid command": {
 			rover: &Rover{
@@ -418,12 +499,111 @@ func TestRover_Valid(t *testing.T) {
 				},
 				Boundary: &Coordinate{1, 1},
 			},
-			expErr: fmt.Errorf("rover provided unknown Instruction{%d}", Instruction('X')),
+			expErr: &ValidationError{Err: &InvalidInstructionError{
+				Step:        3,
+				Instruction: 'X',
+				Err:         fmt.Errorf("%w{%d}", ErrUnknownInstruction, Instruction('X')),
+			}},
+		},
+		"nil error if rover is at a negative origin": {
+			rover: &Rover{
+				Commands: "M",
+				Position: &Position{Coordinate{-5, -5}, North},
+				Origin:   Coordinate{-5, -5},
+				Boundary: &Coordinate{5, 5},
+			},
+		},
+		"err if rover.Boundary.X below the origin": {
+			rover: &Rover{
+				Commands: "M",
+				Position: &Position{Coordinate{-5, -5}, North},
+				Origin:   Coordinate{-5, -5},
+				Boundary: &Coordinate{-6, 5},
+			},
+			expErr: &ValidationError{Err: fmt.Errorf("%w : x boundary %d", ErrNegativeBoundary, -6)},
+		},
+		"err if rover.Position.Y below the origin": {
+			rover: &Rover{
+				Commands: "M",
+				Position: &Position{Coordinate{0, -6}, North},
+				Origin:   Coordinate{-5, -5},
+				Boundary: &Coordinate{5, 5},
+			},
+			expErr: &ValidationError{Err: ErrRoverOutsideYBoundary},
 		},
 	}
 
 	for desc, test := range tests {
 		err := test.rover.Valid()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+	}
+}
+
+//withRover sets the rover on an expected error, which cannot reference the rover from within the test table.
+func withRover(err error, r *Rover) error {
+	switch e := err.(type) {
+	case *MovementError:
+		e.Rover = r
+	case *InvalidInstructionError:
+		e.Rover = r
+	case *ValidationError:
+		e.Rover = r
+		withRover(e.Err, r)
+	case *UnreachableError:
+		e.Rover = r
+	}
+
+	return err
+}
+
+func TestRovers_Find(t *testing.T) {
+	spirit := &Rover{Name: "Spirit", Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1}}
+	opportunity := &Rover{Name: "Opportunity", Commands: "M", Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{1, 1}}
+	rovers := Rovers{spirit, opportunity}
+
+	tests := map[string]struct {
+		name     string
+		expRover *Rover
+		expIndex int
+		expErr   error
+	}{
+		"finds first rover by name": {
+			name:     "Spirit",
+			expRover: spirit,
+			expIndex: 0,
+		},
+		"finds second rover by name": {
+			name:     "Opportunity",
+			expRover: opportunity,
+			expIndex: 1,
+		},
+		"err no rover with name": {
+			name:     "Curiosity",
+			expIndex: -1,
+			expErr:   fmt.Errorf("%w : %s", ErrRoverNotFound, "Curiosity"),
+		},
+	}
+
+	for desc, test := range tests {
+		r, err := rovers.Find(test.name)
 		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Samef(t, test.expRover, r, "%s failed, expected rover %v but got %v", desc, test.expRover, r)
+		assert.Equalf(t, test.expIndex, rovers.Index(test.name), "%s failed, expected index %d but got %d", desc, test.expIndex, rovers.Index(test.name))
 	}
 }
+
+func TestRovers_Valid(t *testing.T) {
+	twin := &Rover{Name: "Spirit", Commands: "M", Position: &Position{Coordinate{1, 0}, North}, Boundary: &Coordinate{1, 1}}
+	rovers := Rovers{
+		{Name: "Spirit", Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1}},
+		{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1}},
+		{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1}},
+		twin,
+	}
+
+	expErr := &ValidationError{Rover: twin, Err: fmt.Errorf("%w : %s", ErrDuplicateRoverName, "Spirit")}
+	err := rovers.Valid()
+	assert.Equalf(t, expErr, err, "expected %v but got %v", expErr, err)
+	assert.Nil(t, rovers[:3].Valid())
+}
@@ -0,0 +1,178 @@
+package rover
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	ErrOffPlateau   = errors.New("rover cannot leave the plateau")
+	ErrInvalidShape = errors.New("invalid plateau shape")
+)
+
+const (
+	//maskOn and maskOff mark the cells of an ASCII mask which are and are not part of the plateau.
+	maskOn  = 'o'
+	maskOff = '.'
+)
+
+//OffPlateauError is returned, wrapped in a *MovementError, when a Rover tries to move off the plateau, and wrapped in a
+//*ValidationError when a Rover is placed off it. It unwraps to the boundary error of the edge crossed, such as
+//ErrBoundaryNorth, or to ErrOffPlateau when the Coordinate is within the boundary but outside the Plateau's Shape.
+//Either way errors.Is(err, ErrOffPlateau) holds.
+type OffPlateauError struct {
+	Coordinate Coordinate
+	Err        error
+}
+
+func (e *OffPlateauError) Error() string {
+	return fmt.Sprintf("%v : (%d, %d)", e.Err, e.Coordinate.X, e.Coordinate.Y)
+}
+
+func (e *OffPlateauError) Unwrap() error {
+	return e.Err
+}
+
+func (e *OffPlateauError) Is(target error) bool {
+	return target == ErrOffPlateau
+}
+
+//Shape is an area of the plateau, such as the outline of an irregular plateau, which Rovers cannot leave even where it
+//lies within their boundary, or the area closed by a Geofence.
+type Shape interface {
+	Contains(c Coordinate) bool
+	Valid() error
+}
+
+//Rectangle is a Shape covering every Coordinate from its Min corner to its Max corner inclusive.
+type Rectangle struct {
+	Min Coordinate
+	Max Coordinate
+}
+
+//Contains reports whether the Coordinate is inside the Rectangle or on its edge.
+func (r Rectangle) Contains(c Coordinate) bool {
+	return c.X >= r.Min.X && c.Y >= r.Min.Y && c.X <= r.Max.X && c.Y <= r.Max.Y
+}
+
+//Valid returns an ErrInvalidShape if the Max corner of the Rectangle is below or left of its Min corner.
+func (r Rectangle) Valid() error {
+	if r.Max.X < r.Min.X || r.Max.Y < r.Min.Y {
+		return fmt.Errorf("%w : rectangle from (%d, %d) to (%d, %d)", ErrInvalidShape, r.Min.X, r.Min.Y, r.Max.X, r.Max.Y)
+	}
+
+	return nil
+}
+
+//Polygon is a Shape whose vertices are joined in order, the last back to the first. A Coordinate on an edge is
+//part of the Shape.
+type Polygon []Coordinate
+
+//Contains reports whether the Coordinate is on the edge of the Polygon or inside it.
+func (p Polygon) Contains(c Coordinate) bool {
+	inside := false
+	for i := range p {
+		a, b := p[i], p[(i+1)%len(p)]
+		if onSegment(a, b, c) {
+			return true
+		}
+
+		//count the edges crossed by a ray running east from the Coordinate
+		if (a.Y > c.Y) != (b.Y > c.Y) {
+			crossing := float64(a.X) + float64(c.Y-a.Y)*float64(b.X-a.X)/float64(b.Y-a.Y)
+			if float64(c.X) < crossing {
+				inside = !inside
+			}
+		}
+	}
+
+	return inside
+}
+
+//Valid returns an ErrInvalidShape if the Polygon has fewer than three vertices.
+func (p Polygon) Valid() error {
+	if len(p) < 3 {
+		return fmt.Errorf("%w : polygon has %d vertices", ErrInvalidShape, len(p))
+	}
+
+	return nil
+}
+
+//onSegment reports whether c lies on the straight line from a to b.
+func onSegment(a, b, c Coordinate) bool {
+	if (b.X-a.X)*(c.Y-a.Y) != (b.Y-a.Y)*(c.X-a.X) {
+		return false
+	}
+
+	return between(c.X, a.X, b.X) && between(c.Y, a.Y, b.Y)
+}
+
+//between reports whether v lies from a to b inclusive, in either order.
+func between(v, a, b int) bool {
+	return (a <= v && v <= b) || (b <= v && v <= a)
+}
+
+//Mask is a Shape made of the Coordinates which are part of the plateau.
+type Mask map[Coordinate]bool
+
+//Contains reports whether the Coordinate is part of the Mask.
+func (m Mask) Contains(c Coordinate) bool {
+	return m[c]
+}
+
+//Valid returns an ErrInvalidShape if the Mask has no Coordinates.
+func (m Mask) Valid() error {
+	for _, on := range m {
+		if on {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w : mask has no cells", ErrInvalidShape)
+}
+
+//LoadMask reads a Mask from an ASCII map where 'o' marks a cell of the plateau and '.' a cell off it. Like a terrain
+//map, the first row is the northern most, and the first column of the last row is at the given origin.
+func LoadMask(r io.Reader, origin Coordinate) (Mask, error) {
+	var rows []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		rows = append(rows, strings.TrimRight(scanner.Text(), "\r"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w : %v", ErrInvalidShape, err)
+	}
+
+	mask := make(Mask)
+	for row, line := range rows {
+		y := origin.Y + len(rows) - 1 - row
+		for column, cell := range []rune(line) {
+			switch cell {
+			case maskOn:
+				mask[Coordinate{X: origin.X + column, Y: y}] = true
+			case maskOff:
+			default:
+				return nil, fmt.Errorf("%w : unknown mask cell %q at row %d column %d", ErrInvalidShape, cell, row+1, column+1)
+			}
+		}
+	}
+
+	return mask, mask.Valid()
+}
+
+//Within reports whether the Coordinate is part of the Plateau's Shape, a Plateau without a Shape covers its boundary.
+func (p *Plateau) Within(c Coordinate) bool {
+	return p == nil || p.Shape == nil || p.Shape.Contains(c)
+}
+
+//validShape returns an ErrInvalidShape if the Plateau has a Shape which is not valid.
+func (p *Plateau) validShape() error {
+	if p == nil || p.Shape == nil {
+		return nil
+	}
+
+	return p.Shape.Valid()
+}
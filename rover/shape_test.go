@@ -0,0 +1,122 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolygon_Contains(t *testing.T) {
+	triangle := Polygon{{0, 0}, {4, 0}, {0, 4}}
+
+	tests := map[string]struct {
+		coordinate Coordinate
+		exp        bool
+	}{
+		"vertex is inside":          {coordinate: Coordinate{0, 0}, exp: true},
+		"edge is inside":            {coordinate: Coordinate{2, 0}, exp: true},
+		"diagonal edge is inside":   {coordinate: Coordinate{2, 2}, exp: true},
+		"interior is inside":        {coordinate: Coordinate{1, 1}, exp: true},
+		"beyond the diagonal":       {coordinate: Coordinate{3, 3}, exp: false},
+		"beyond the last vertex":    {coordinate: Coordinate{0, 5}, exp: false},
+		"behind the first vertex":   {coordinate: Coordinate{-1, 0}, exp: false},
+		"level with a vertex above": {coordinate: Coordinate{-1, 4}, exp: false},
+	}
+
+	for desc, test := range tests {
+		got := triangle.Contains(test.coordinate)
+		assert.Equalf(t, test.exp, got, "%s failed, expected %v to be inside %t but got %t", desc, test.coordinate, test.exp, got)
+	}
+}
+
+func TestLoadMask(t *testing.T) {
+	tests := map[string]struct {
+		input   string
+		origin  Coordinate
+		expMask Mask
+		expErr  error
+	}{
+		"first row is the northern most": {
+			input:   ".o\noo\n",
+			expMask: Mask{{1, 1}: true, {0, 0}: true, {1, 0}: true},
+		},
+		"anchored at the origin": {
+			input:   "o.\n.o",
+			origin:  Coordinate{-3, 2},
+			expMask: Mask{{-3, 3}: true, {-2, 2}: true},
+		},
+		"err unknown cell": {
+			input:  "o#\n",
+			expErr: fmt.Errorf("%w : unknown mask cell %q at row %d column %d", ErrInvalidShape, '#', 1, 2),
+		},
+		"err no cells on the plateau": {
+			input:  "..\n..\n",
+			expErr: fmt.Errorf("%w : mask has no cells", ErrInvalidShape),
+		},
+	}
+
+	for desc, test := range tests {
+		mask, err := LoadMask(strings.NewReader(test.input), test.origin)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		if err == nil {
+			assert.Equalf(t, test.expMask, mask, "%s failed, expected mask %v but got %v", desc, test.expMask, mask)
+		}
+	}
+}
+
+func TestRover_ExploreShape(t *testing.T) {
+	//a triangle with its long edge running from the north west to the south east corner
+	shape := &Plateau{Shape: Polygon{{0, 0}, {2, 0}, {0, 2}}}
+
+	tests := map[string]struct {
+		rover       *Rover
+		expErr      error
+		expPosition Position
+	}{
+		"explore along the edge of the shape": {
+			rover: &Rover{Commands: "MMRRMLM", Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2}, Plateau: shape},
+			expPosition: Position{Coordinate{1, 1}, East},
+		},
+		"err leaving the shape within the boundary": {
+			rover: &Rover{Commands: "RMM", Position: &Position{Coordinate{0, 1}, North},
+				Boundary: &Coordinate{2, 2}, Plateau: shape},
+			expErr: &MovementError{
+				Step:        2,
+				Instruction: Move,
+				Position:    Position{Coordinate{1, 1}, East},
+				Attempted:   Coordinate{2, 1},
+				Err:         &OffPlateauError{Coordinate: Coordinate{2, 1}, Err: ErrOffPlateau},
+			},
+			expPosition: Position{Coordinate{1, 1}, East},
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Explore()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expPosition, *test.rover.Position, "%s failed, expected position %v but got %v", desc, test.expPosition, *test.rover.Position)
+	}
+
+	err := (&Rover{Commands: "M", Position: &Position{Coordinate{2, 2}, North}, Boundary: &Coordinate{2, 2},
+		Plateau: shape}).Valid()
+	assert.Truef(t, errors.Is(err, ErrOffPlateau), "expected a rover outside the shape to be off the plateau but got %v", err)
+
+	err = (&Rover{Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 2},
+		Plateau: &Plateau{Shape: Polygon{{0, 0}, {2, 2}}}}).Valid()
+	assert.Truef(t, errors.Is(err, ErrInvalidShape), "expected a two vertex polygon to be invalid but got %v", err)
+}
+
+func TestRovers_RenderShape(t *testing.T) {
+	rovers := Rovers{{Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 1},
+		Plateau: &Plateau{Shape: Mask{{0, 0}: true, {1, 0}: true, {2, 0}: true, {1, 1}: true}}}}
+
+	exp := " . \n" +
+		"^..\n"
+	rendered := rovers.Render()
+	assert.Equalf(t, exp, rendered, "expected\n%s\nbut got\n%s", exp, rendered)
+}
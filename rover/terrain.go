@@ -0,0 +1,159 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknownTerrain    = errors.New("unknown terrain")
+	ErrImpassableTerrain = errors.New("terrain ahead is impassable for the rover")
+)
+
+//Terrain classifies the ground of a Coordinate, Coordinates without a classification are Ground.
+type Terrain int
+
+const (
+	Ground Terrain = iota
+	Sand
+	Rock
+	Ice
+)
+
+var terrainNames = map[Terrain]string{
+	Ground: "ground",
+	Sand:   "sand",
+	Rock:   "rock",
+	Ice:    "ice",
+}
+
+//TerrainLegend is the character used for each Terrain in ASCII terrain maps and renderings.
+var TerrainLegend = map[rune]Terrain{
+	'.': Ground,
+	's': Sand,
+	'r': Rock,
+	'i': Ice,
+}
+
+func (t Terrain) String() string {
+	if name, ok := terrainNames[t]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Terrain(%d)", int(t))
+}
+
+//Valid will return an error if the Terrain is unknown.
+func (t Terrain) Valid() error {
+	if _, ok := terrainNames[t]; !ok {
+		return fmt.Errorf("%w %d", ErrUnknownTerrain, int(t))
+	}
+
+	return nil
+}
+
+//glyph returns the character the Terrain is drawn with.
+func (t Terrain) glyph() rune {
+	for glyph, terrain := range TerrainLegend {
+		if terrain == t {
+			return glyph
+		}
+	}
+
+	return glyphGround
+}
+
+//Traversal describes how a Rover crosses a Terrain. Cost is the extra energy drawn from the Rover's Battery for each
+//move onto the Terrain, which planners also weigh as that many extra instructions. An Impassable Terrain cannot be
+//entered, and a Rover moving onto a Terrain which makes it Slide carries on one more Coordinate in the same Direction,
+//unless that Coordinate cannot be moved onto.
+type Traversal struct {
+	Cost       float64
+	Impassable bool
+	Slide      bool
+}
+
+//DefaultTraversal is how a Rover crosses each Terrain when it does not set its own Traversal.
+var DefaultTraversal = map[Terrain]Traversal{
+	Ground: {},
+	Sand:   {Cost: 1},
+	Rock:   {Cost: 0.5},
+	Ice:    {Slide: true},
+}
+
+//TerrainError is returned, wrapped in a *MovementError, when a Rover tries to move onto a Terrain it cannot enter.
+//It unwraps to ErrImpassableTerrain.
+type TerrainError struct {
+	Coordinate Coordinate
+	Terrain    Terrain
+}
+
+func (e *TerrainError) Error() string {
+	return fmt.Sprintf("%v : %v at %v", ErrImpassableTerrain, e.Terrain, e.Coordinate)
+}
+
+func (e *TerrainError) Unwrap() error {
+	return ErrImpassableTerrain
+}
+
+//SetTerrain classifies the ground of the Coordinate.
+func (p *Plateau) SetTerrain(c Coordinate, t Terrain) {
+	if p.Terrain == nil {
+		p.Terrain = make(map[Coordinate]Terrain)
+	}
+	p.Terrain[c] = t
+}
+
+//TerrainAt returns the Terrain of the Coordinate, anywhere without a classification is Ground.
+func (p *Plateau) TerrainAt(c Coordinate) Terrain {
+	if p == nil {
+		return Ground
+	}
+
+	return p.Terrain[c]
+}
+
+//traversal returns how the Rover crosses the Terrain of the Coordinate. Terrains missing from the Rover's Traversal use
+//DefaultTraversal, as does a Rover without a Traversal.
+func (r *Rover) traversal(c Coordinate) Traversal {
+	t := r.Plateau.TerrainAt(c)
+	if traversal, ok := r.Traversal[t]; ok {
+		return traversal
+	}
+
+	return DefaultTraversal[t]
+}
+
+//validTraversal will return an error if the Rover's Traversal describes an unknown Terrain or a negative cost.
+func (r *Rover) validTraversal() error {
+	for t, traversal := range r.Traversal {
+		if err := t.Valid(); err != nil {
+			return err
+		}
+
+		if traversal.Cost < 0 {
+			return fmt.Errorf("%w : %v costs %g", ErrInvalidCost, t, traversal.Cost)
+		}
+	}
+
+	return nil
+}
+
+//entryCost returns the Traversal cost of the Terrain the Instruction steps onto from the given Position.
+func (r *Rover) entryCost(from Position, instruction Instruction) float64 {
+	if instruction != Move {
+		return 0
+	}
+
+	stepped, _, err := r.stepsFrom(from)
+	if err != nil {
+		return 0
+	}
+
+	var cost float64
+	for _, c := range stepped {
+		cost += r.traversal(c).Cost
+	}
+
+	return cost
+}
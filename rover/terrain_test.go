@@ -0,0 +1,130 @@
+package rover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//terrainPlateau is a plateau with the given Terrain at (0, 1).
+func terrainPlateau(t Terrain) *Plateau {
+	p := &Plateau{}
+	p.SetTerrain(Coordinate{0, 1}, t)
+
+	return p
+}
+
+func TestRover_ExploreTerrain(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		expErr      error
+		expPosition Position
+		expCharge   float64
+	}{
+		"sand costs extra energy to move onto": {
+			rover: &Rover{
+				Commands: "MM",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  terrainPlateau(Sand),
+				Battery:  &Battery{Capacity: 10, Charge: 10},
+			},
+			expPosition: Position{Coordinate{0, 2}, North},
+			expCharge:   7,
+		},
+		"rover traversal overrides the default": {
+			rover: &Rover{
+				Commands:  "M",
+				Position:  &Position{Coordinate{0, 0}, North},
+				Boundary:  &Coordinate{2, 2},
+				Plateau:   terrainPlateau(Sand),
+				Battery:   &Battery{Capacity: 10, Charge: 10},
+				Traversal: map[Terrain]Traversal{Sand: {Cost: 3}},
+			},
+			expPosition: Position{Coordinate{0, 1}, North},
+			expCharge:   6,
+		},
+		"ice slides the rover one further": {
+			rover: &Rover{
+				Commands: "M",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  terrainPlateau(Ice),
+			},
+			expPosition: Position{Coordinate{0, 2}, North},
+		},
+		"ice slide stops at the boundary": {
+			rover: &Rover{
+				Commands: "M",
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 1},
+				Plateau:  terrainPlateau(Ice),
+			},
+			expPosition: Position{Coordinate{0, 1}, North},
+		},
+		"err impassable terrain": {
+			rover: &Rover{
+				Commands:  "RLM",
+				Position:  &Position{Coordinate{0, 0}, North},
+				Boundary:  &Coordinate{2, 2},
+				Plateau:   terrainPlateau(Ice),
+				Traversal: map[Terrain]Traversal{Ice: {Impassable: true}},
+			},
+			expErr: &MovementError{
+				Step:        2,
+				Instruction: Move,
+				Position:    Position{Coordinate{0, 0}, North},
+				Attempted:   Coordinate{0, 1},
+				Err:         &TerrainError{Coordinate: Coordinate{0, 1}, Terrain: Ice},
+			},
+			expPosition: Position{Coordinate{0, 0}, North},
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Explore()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expPosition, *test.rover.Position, "%s failed, expected position %v but got %v", desc, test.expPosition, *test.rover.Position)
+		assert.Equalf(t, test.expCharge, test.rover.Battery.remaining(), "%s failed, expected charge %g but got %g", desc, test.expCharge, test.rover.Battery.remaining())
+	}
+}
+
+func TestPlan_Terrain(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		expCommands string
+	}{
+		"crosses sand when going around costs more": {
+			rover: &Rover{
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  terrainPlateau(Sand),
+			},
+			expCommands: "MM",
+		},
+		"goes around sand when crossing costs more": {
+			rover: &Rover{
+				Position:  &Position{Coordinate{0, 0}, North},
+				Boundary:  &Coordinate{2, 2},
+				Plateau:   terrainPlateau(Sand),
+				Traversal: map[Terrain]Traversal{Sand: {Cost: 10}},
+			},
+			expCommands: "RMLMMLM",
+		},
+		"slides across ice": {
+			rover: &Rover{
+				Position: &Position{Coordinate{0, 0}, North},
+				Boundary: &Coordinate{2, 2},
+				Plateau:  terrainPlateau(Ice),
+			},
+			expCommands: "M",
+		},
+	}
+
+	for desc, test := range tests {
+		commands, err := Plan(test.rover, Coordinate{0, 2}, UnknownDirection, nil)
+		assert.NoErrorf(t, err, "%s failed, expected no error but got %v", desc, err)
+		assert.Equalf(t, test.expCommands, commands, "%s failed, expected commands %s but got %s", desc, test.expCommands, commands)
+	}
+}
@@ -0,0 +1,83 @@
+package rover
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrUnknownTopology = errors.New("unknown topology")
+	ErrInvalidWorld    = errors.New("invalid world")
+)
+
+//Topology describes what lies beyond the edges of the plateau.
+type Topology uint8
+
+const (
+	//Bounded plateaus end at their edges, Rovers cannot move past the Origin or Boundary.
+	Bounded Topology = iota
+	//World plateaus cover a whole planet. X is longitude and wraps around, so moving east past the Boundary arrives
+	//back at the Origin. Y is latitude, with the poles beyond the Origin and the Boundary, so moving over a pole
+	//arrives on the same row at the opposite longitude, facing back the way the Rover came.
+	World
+)
+
+//Valid will return an error if the Topology is not Bounded or World.
+func (t Topology) Valid() error {
+	switch t {
+	case Bounded:
+	case World:
+	default:
+		return fmt.Errorf("%w Topology{%d}", ErrUnknownTopology, t)
+	}
+
+	return nil
+}
+
+//validTopology will return an error if the Rover's Topology is not valid, or is a World the Rover's plateau cannot
+//wrap around. A World must have a Square Grid and an even number of columns, so every column has an opposite.
+func (r *Rover) validTopology() error {
+	if err := r.Topology.Valid(); err != nil {
+		return err
+	}
+
+	if r.Topology != World {
+		return nil
+	}
+
+	if r.Grid != Square {
+		return fmt.Errorf("%w : must have a square grid", ErrInvalidWorld)
+	}
+
+	if width := r.Boundary.X - r.Origin.X + 1; width%2 != 0 {
+		return fmt.Errorf("%w : %d columns cannot be split between hemispheres", ErrInvalidWorld, width)
+	}
+
+	return nil
+}
+
+//wrap returns the Position a single step off the edge of the plateau arrives at on the Rover's Topology. On a World a
+//step past the Origin or Boundary X wraps around to the other, and a step over a pole lands on the same row at the
+//opposite longitude with the Direction reversed. Positions on a Bounded plateau, or already on the plateau, are
+//returned as they are.
+func (r *Rover) wrap(p Position) Position {
+	if r.Topology != World {
+		return p
+	}
+
+	width := r.Boundary.X - r.Origin.X + 1
+	switch {
+	case p.Y > r.Boundary.Y:
+		p.Y = r.Boundary.Y
+		p.X += width / 2
+		p.Direction = r.Grid.reverse(p.Direction)
+	case p.Y < r.Origin.Y:
+		p.Y = r.Origin.Y
+		p.X += width / 2
+		p.Direction = r.Grid.reverse(p.Direction)
+	}
+
+	p.X = r.Origin.X + ((p.X-r.Origin.X)%width+width)%width
+
+	return p
+}
@@ -0,0 +1,109 @@
+package rover
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRover_ExploreWorld(t *testing.T) {
+	tests := map[string]struct {
+		rover       *Rover
+		expErr      error
+		expPosition Position
+	}{
+		"east past the edge wraps to the west": {
+			rover:       &Rover{Topology: World, Commands: "M", Position: &Position{Coordinate{3, 1}, East}, Boundary: &Coordinate{3, 2}},
+			expPosition: Position{Coordinate{0, 1}, East},
+		},
+		"west past the edge wraps to the east": {
+			rover:       &Rover{Topology: World, Commands: "M", Position: &Position{Coordinate{0, 1}, West}, Boundary: &Coordinate{3, 2}},
+			expPosition: Position{Coordinate{3, 1}, West},
+		},
+		"around the world back to the start": {
+			rover:       &Rover{Topology: World, Commands: "MMMM", Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{3, 2}},
+			expPosition: Position{Coordinate{0, 0}, East},
+		},
+		"over the north pole": {
+			rover:       &Rover{Topology: World, Commands: "MM", Position: &Position{Coordinate{1, 2}, North}, Boundary: &Coordinate{3, 2}},
+			expPosition: Position{Coordinate{3, 1}, South},
+		},
+		"over the south pole from a non-zero origin": {
+			rover: &Rover{Topology: World, Commands: "M", Position: &Position{Coordinate{-1, -2}, South}, Origin: Coordinate{-2, -2},
+				Boundary: &Coordinate{1, 2}},
+			expPosition: Position{Coordinate{1, -2}, North},
+		},
+		"err obstacle beyond the edge": {
+			rover: &Rover{Topology: World, Commands: "M", Position: &Position{Coordinate{3, 1}, East}, Boundary: &Coordinate{3, 2},
+				Plateau: &Plateau{Obstacles: map[Coordinate]bool{{0, 1}: true}}},
+			expErr: &MovementError{
+				Instruction: Move,
+				Position:    Position{Coordinate{3, 1}, East},
+				Attempted:   Coordinate{0, 1},
+				Err:         ErrObstacle,
+			},
+			expPosition: Position{Coordinate{3, 1}, East},
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Explore()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+		assert.Equalf(t, test.expPosition, *test.rover.Position, "%s failed, expected position %v but got %v", desc, test.expPosition, *test.rover.Position)
+	}
+}
+
+func TestRover_ValidTopology(t *testing.T) {
+	tests := map[string]struct {
+		rover  *Rover
+		expErr error
+	}{
+		"world with an even number of columns": {
+			rover: &Rover{Topology: World, Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1}},
+		},
+		"err world with an odd number of columns": {
+			rover:  &Rover{Topology: World, Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{2, 1}},
+			expErr: &ValidationError{Err: fmt.Errorf("%w : %d columns cannot be split between hemispheres", ErrInvalidWorld, 3)},
+		},
+		"err world on a hex grid": {
+			rover:  &Rover{Topology: World, Grid: Hex, Commands: "M", Position: &Position{Coordinate{0, 0}, East}, Boundary: &Coordinate{1, 1}},
+			expErr: &ValidationError{Err: fmt.Errorf("%w : must have a square grid", ErrInvalidWorld)},
+		},
+		"err unknown topology": {
+			rover:  &Rover{Topology: 7, Commands: "M", Position: &Position{Coordinate{0, 0}, North}, Boundary: &Coordinate{1, 1}},
+			expErr: &ValidationError{Err: fmt.Errorf("%w Topology{%d}", ErrUnknownTopology, 7)},
+		},
+	}
+
+	for desc, test := range tests {
+		err := test.rover.Valid()
+		test.expErr = withRover(test.expErr, test.rover)
+		assert.Equalf(t, test.expErr, err, "%s failed, expected %v but got %v", desc, test.expErr, err)
+	}
+}
+
+func TestPlan_World(t *testing.T) {
+	for topology, exp := range map[Topology]string{Bounded: "LLMMMLL", World: "M"} {
+		r := &Rover{Topology: topology, Position: &Position{Coordinate{0, 0}, West}, Boundary: &Coordinate{3, 2}}
+
+		commands, err := Plan(r, Coordinate{3, 0}, West, nil)
+		assert.Nilf(t, err, "expected a plan on topology %d but got %v", topology, err)
+		assert.Equalf(t, exp, commands, "expected the shortest plan on topology %d", topology)
+	}
+
+	//over the pole is shorter than driving around to the far side
+	r := &Rover{Topology: World, Position: &Position{Coordinate{0, 2}, North}, Boundary: &Coordinate{3, 2}}
+	commands, err := Plan(r, Coordinate{2, 2}, South, nil)
+	assert.Nilf(t, err, "expected a plan over the pole but got %v", err)
+	assert.Equalf(t, "M", commands, "expected to plan over the pole")
+}
+
+func TestOdometry_ReckonWorld(t *testing.T) {
+	r := &Rover{Topology: World, Commands: "M", Position: &Position{Coordinate{1, 2}, North}, Boundary: &Coordinate{3, 2},
+		Odometry: NewOdometry(Position{Coordinate{1, 2}, North}, 0)}
+
+	assert.Nilf(t, r.Explore(), "expected the rover to cross the pole")
+	assert.Equalf(t, *r.Position, r.Odometry.Believed, "expected the belief to cross the pole with the rover")
+}
@@ -0,0 +1,63 @@
+package rover
+
+//Trace records what every Rover did on each tick of a Mission.
+type Trace struct {
+	Ticks []Tick
+	//Observed holds every Coordinate each Rover's Camera has seen, in the same order as the Rovers.
+	Observed []map[Coordinate]bool
+}
+
+//newTrace returns an empty Trace with the Coordinates each Rover can see from its starting Position already observed.
+func newTrace(rovers Rovers) *Trace {
+	t := &Trace{
+		Observed: make([]map[Coordinate]bool, len(rovers)),
+	}
+
+	for i, r := range rovers {
+		t.Observed[i] = make(map[Coordinate]bool)
+		t.observe(i, r.Observe())
+	}
+
+	return t
+}
+
+//observe adds the Coordinates to those seen by the Rover at the given index.
+func (t *Trace) observe(i int, seen []Coordinate) {
+	for _, c := range seen {
+		t.Observed[i][c] = true
+	}
+}
+
+//Tick is a single time step of a Mission, holding one Step for each Rover that had an instruction to execute.
+//When the Mission has a Clock, the Tick also records the sol, the phase of the sol and the sunlight during it.
+//Hazards holds the Coordinate of each Hazard during the Tick by name, Missions without Hazards leave it nil.
+type Tick struct {
+	Number   int
+	Sol      int
+	Phase    float64
+	Sunlight float64
+	Hazards  map[string]Coordinate
+	Steps    []Step
+}
+
+//Step records a Rover executing, or yielding on, a single Instruction.
+//Rover is the index of the Rover within the Mission's Rovers, Name is its name if it has one.
+type Step struct {
+	Rover       int
+	Name        string
+	Instruction Instruction
+	From        Position
+	To          Position
+	Yielded     bool
+	//Fault is the Fault injected into the Step, if any.
+	Fault Fault
+	//Believed is where the Rover believed it was after the Step, and Uncertainty how far from there it expected to be.
+	//Rovers without Odometry believe their true Position with no Uncertainty.
+	Believed    Position
+	Uncertainty float64
+	//Charge is the charge left in the Rover's Battery after the Step, Rovers without a Battery have no charge.
+	Charge float64
+	//Observed holds the Coordinates the Rover's Camera saw after the Step.
+	Observed []Coordinate
+}
+